@@ -1,6 +1,8 @@
 package algorithms
 
 import (
+	"context"
+
 	"github.com/MatProGo-dev/MatProInterface.go/problem"
 	simplex_solution "github.com/MatProGo-dev/simplex/solution"
 )
@@ -9,3 +11,11 @@ type AlgorithmInterface interface {
 	// Solves the provided optimization problem.
 	Solve(prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error)
 }
+
+// ContextAlgorithmInterface is implemented by algorithms that can stop early
+// (e.g., when a deadline passes) by watching a context.
+type ContextAlgorithmInterface interface {
+	AlgorithmInterface
+	// Solves the provided optimization problem, stopping early once ctx is done.
+	SolveContext(ctx context.Context, prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error)
+}
@@ -1,5 +1,45 @@
 package algorithms
 
+import "fmt"
+
 type AlgorithmType int
 
 const TypeNaiveTableau AlgorithmType = AlgorithmType(1)
+const TypeExactTableau AlgorithmType = AlgorithmType(2)
+const TypeSparseRevised AlgorithmType = AlgorithmType(3)
+
+/*
+String
+Description:
+
+	Returns the name of the algorithm type (e.g., as used on the command line).
+*/
+func (at AlgorithmType) String() string {
+	switch at {
+	case TypeNaiveTableau:
+		return "tableau"
+	case TypeExactTableau:
+		return "exact-tableau"
+	case TypeSparseRevised:
+		return "sparse-revised"
+	}
+	return fmt.Sprintf("AlgorithmType(%d)", int(at))
+}
+
+/*
+ToAlgorithmType
+Description:
+
+	Converts the name of an algorithm type (see String) back into an AlgorithmType.
+*/
+func ToAlgorithmType(name string) (AlgorithmType, error) {
+	switch name {
+	case TypeNaiveTableau.String():
+		return TypeNaiveTableau, nil
+	case TypeExactTableau.String():
+		return TypeExactTableau, nil
+	case TypeSparseRevised.String():
+		return TypeSparseRevised, nil
+	}
+	return 0, fmt.Errorf("unknown algorithm type \"%v\"", name)
+}
@@ -0,0 +1,137 @@
+/*
+Package revised_algorithm solves linear programs with the revised simplex method on a
+sparse standard form. The constraint matrix is stored in CSC (and CSR) format and the
+basis in product form (see utils.SparseBasis), so no dense tableau is ever built and
+problems with tens of thousands of rows and columns but few nonzeros can be solved.
+*/
+package revised_algorithm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	tableau_termination "github.com/MatProGo-dev/simplex/algorithms/tableau/termination"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+)
+
+// defaultPivotTolerance is the magnitude below which entries of the entering column are
+// ignored by the ratio test (unless RevisedSimplexAlgorithm.PivotTolerance is set).
+const defaultPivotTolerance = 1e-9
+
+// defaultRefactorizationFrequency is the number of pivots after which the basis is
+// refactorized (unless RevisedSimplexAlgorithm.RefactorizationFrequency is set).
+const defaultRefactorizationFrequency = 100
+
+/*
+RevisedSimplexAlgorithm
+Description:
+
+	The revised simplex method on the sparse standard form of a problem
+	(see utils.GetSparseStandardFormFrom). Each iteration prices the columns with the
+	duals y = B^{-T} c_B (visiting only the rows of A where y is nonzero), computes the
+	entering column B^{-1} A_j from the sparse column A_j and makes the ratio test on it.
+	The entering variable is the one with the largest reduced cost (the smallest index on
+	ties) and the exiting variable is the one of the row with the smallest ratio (the
+	smallest variable index on ties), like Bland's Rule selects them for the tableau.
+	Like TableauAlgorithm, it starts from the slack basis, so the slack basis must be
+	feasible and every row needs a slack (i.e., there can be no equality constraints).
+	The solution contains the variable values, the objective value and the constraint
+	activities, but not the dual values, the reduced costs or the basis.
+*/
+type RevisedSimplexAlgorithm struct {
+	IterationLimit int
+	// PivotTolerance is the magnitude below which entries of the entering column are
+	// ignored by the ratio test. If it is zero, then 1e-9 is used.
+	PivotTolerance float64
+	// OptimalityTolerance is the amount by which a reduced cost may be positive
+	// while the basis is still considered optimal.
+	OptimalityTolerance float64
+	// RefactorizationFrequency is the number of pivots after which the basis is
+	// refactorized from A. If it is zero, then 100 is used; if it is negative, then
+	// the basis is never refactorized.
+	RefactorizationFrequency int
+}
+
+/*
+GetPivotTolerance
+Description:
+
+	Returns the pivot tolerance used by the algorithm (1e-9 by default).
+*/
+func (algo *RevisedSimplexAlgorithm) GetPivotTolerance() float64 {
+	if algo.PivotTolerance <= 0 {
+		return defaultPivotTolerance
+	}
+	return algo.PivotTolerance
+}
+
+/*
+GetRefactorizationFrequency
+Description:
+
+	Returns the number of pivots between two refactorizations (100 by default,
+	0 if refactorization is disabled).
+*/
+func (algo *RevisedSimplexAlgorithm) GetRefactorizationFrequency() int {
+	switch {
+	case algo.RefactorizationFrequency == 0:
+		return defaultRefactorizationFrequency
+	case algo.RefactorizationFrequency < 0:
+		return 0
+	}
+	return algo.RefactorizationFrequency
+}
+
+/*
+Solve
+Description:
+
+	Solves the problem with the revised simplex method.
+*/
+func (algo *RevisedSimplexAlgorithm) Solve(prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
+	return algo.SolveContext(context.Background(), prob)
+}
+
+/*
+SolveContext
+Description:
+
+	Same as Solve, but stops pivoting as soon as ctx is done. The solution then
+	describes the last basis that was visited and has the status TIME_LIMIT (if the
+	context's deadline passed) or INTERRUPTED (if the context was cancelled).
+*/
+func (algo *RevisedSimplexAlgorithm) SolveContext(ctx context.Context, prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
+	// Setup
+	started := time.Now()
+	s, err := algo.newState(&prob)
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, err
+	}
+
+	// Pivot until the algorithm terminates
+	condition := tableau_termination.DidNotTerminate
+	for condition == tableau_termination.DidNotTerminate {
+		switch {
+		case ctx.Err() == context.DeadlineExceeded:
+			condition = tableau_termination.TimeLimitReached
+		case ctx.Err() == context.Canceled:
+			condition = tableau_termination.Interrupted
+		case s.iterations >= algo.IterationLimit:
+			condition = tableau_termination.MaximumIterationsReached
+		default:
+			condition, err = s.next()
+			if err != nil {
+				return simplex_solution.SimplexSolution{}, fmt.Errorf(
+					"There was an issue pivoting at iteration %v: %v",
+					s.iterations,
+					err,
+				)
+			}
+		}
+	}
+	s.statistics.TotalTime = time.Since(started)
+
+	return s.toSolution(condition, &prob)
+}
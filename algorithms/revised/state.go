@@ -0,0 +1,273 @@
+package revised_algorithm
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	tableau_termination "github.com/MatProGo-dev/simplex/algorithms/tableau/termination"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+// refactorizationTolerance is the relative change of a basic variable's value above which
+// a refactorization is reported as a warning.
+const refactorizationTolerance = 1e-9
+
+/*
+revisedState
+Description:
+
+	The state of a solve with the revised simplex method: the sparse standard form
+	(with its rows in CSR format for pricing), the basis and the values of the basic
+	variables (xBasic[i] is the value of basis.BasicVariableIndicies[i]).
+*/
+type revisedState struct {
+	algo         *RevisedSimplexAlgorithm
+	standardForm utils.SparseStandardForm
+	varMap       map[symbolic.Variable]symbolic.Expression
+	rows         utils.CSRMatrix
+
+	basis   *utils.SparseBasis
+	xBasic  []float64
+	isBasic []bool
+
+	iterations                 int
+	pivotsSinceRefactorization int
+	statistics                 simplex_solution.Statistics
+	warnings                   []string
+
+	// Work vectors (one entry per row)
+	duals  []float64
+	column []float64
+}
+
+/*
+newState
+Description:
+
+	Creates the state of the slack basis of the problem's sparse standard form and
+	checks that the slack basis is feasible.
+*/
+func (algo *RevisedSimplexAlgorithm) newState(prob *problem.OptimizationProblem) (*revisedState, error) {
+	// Setup
+	standardForm, varMap, err := utils.GetSparseStandardFormFrom(prob)
+	if err != nil {
+		return nil, fmt.Errorf("there was an issue creating the sparse standard form: %v", err)
+	}
+
+	basis, err := utils.NewSparseBasis(&standardForm.A, standardForm.SlackBasis, algo.GetPivotTolerance())
+	if err != nil {
+		return nil, fmt.Errorf("RevisedSimplexAlgorithm: %v", err)
+	}
+
+	nRows, nCols := standardForm.A.Dims()
+	s := &revisedState{
+		algo:         algo,
+		standardForm: standardForm,
+		varMap:       varMap,
+		rows:         standardForm.A.ToCSR(),
+		basis:        basis,
+		isBasic:      make([]bool, nCols),
+		duals:        make([]float64, nRows),
+		column:       make([]float64, nRows),
+	}
+	for _, bvIdx := range basis.BasicVariableIndicies {
+		s.isBasic[bvIdx] = true
+	}
+	s.xBasic = s.basicSolution()
+
+	// Verify
+	for ii, value := range s.xBasic {
+		if value < -algo.GetPivotTolerance() {
+			return nil, fmt.Errorf(
+				"RevisedSimplexAlgorithm: the slack basis is infeasible (the slack of row %v has the value %v)",
+				ii,
+				value,
+			)
+		}
+	}
+
+	return s, nil
+}
+
+/*
+next
+Description:
+
+	Performs one iteration: prices the nonbasic columns, makes the ratio test on the
+	entering column and pivots. Returns OptimalSolutionFound if no column can improve
+	the objective, ProblemIsUnbounded if the entering column has no positive entry and
+	DidNotTerminate after a pivot.
+*/
+func (s *revisedState) next() (tableau_termination.TerminationType, error) {
+	// Setup
+	started := time.Now()
+	tolerance := s.algo.GetPivotTolerance()
+
+	// Price: y = B^{-T} c_B and d_j = c_j - y^T A_j
+	c := s.standardForm.C
+	for ii, bvIdx := range s.basis.BasicVariableIndicies {
+		s.duals[ii] = c[bvIdx]
+	}
+	s.basis.BTRAN(s.duals)
+	yTA := s.rows.TMulVec(s.duals)
+
+	enteringVarIdx, bestReducedCost := -1, s.algo.OptimalityTolerance
+	for jj := range c {
+		if s.isBasic[jj] {
+			continue
+		}
+		if reducedCost := c[jj] - yTA[jj]; reducedCost > bestReducedCost {
+			enteringVarIdx, bestReducedCost = jj, reducedCost
+		}
+	}
+	if enteringVarIdx == -1 {
+		return tableau_termination.OptimalSolutionFound, nil
+	}
+
+	// Ratio test on alpha = B^{-1} A_j
+	s.basis.ColumnOf(enteringVarIdx, s.column)
+	exitingRow, minRatio := -1, 0.0
+	for ii, bvIdx := range s.basis.BasicVariableIndicies {
+		if s.column[ii] <= tolerance {
+			continue
+		}
+		ratio := max(s.xBasic[ii], 0.0) / s.column[ii]
+		if exitingRow == -1 || ratio < minRatio || (ratio == minRatio && bvIdx < s.basis.BasicVariableIndicies[exitingRow]) {
+			exitingRow, minRatio = ii, ratio
+		}
+	}
+	if exitingRow == -1 {
+		return tableau_termination.ProblemIsUnbounded, nil
+	}
+
+	// Pivot
+	theta := max(s.xBasic[exitingRow], 0.0) / s.column[exitingRow]
+	for ii := range s.xBasic {
+		s.xBasic[ii] -= theta * s.column[ii]
+	}
+	s.xBasic[exitingRow] = theta
+
+	s.isBasic[s.basis.BasicVariableIndicies[exitingRow]] = false
+	s.isBasic[enteringVarIdx] = true
+	err := s.basis.Replace(exitingRow, enteringVarIdx, s.column)
+	if err != nil {
+		return tableau_termination.DidNotTerminate, err
+	}
+	s.iterations++
+
+	degenerate := 0
+	if theta <= tolerance {
+		degenerate = 1
+	}
+	s.statistics.RecordPivots(simplex_solution.PhasePrimalSimplex, 1, degenerate, time.Since(started))
+
+	// Refactorize the basis if it is due
+	s.pivotsSinceRefactorization++
+	if frequency := s.algo.GetRefactorizationFrequency(); frequency > 0 && s.pivotsSinceRefactorization >= frequency {
+		s.refactorize()
+	}
+
+	return tableau_termination.DidNotTerminate, nil
+}
+
+/*
+refactorize
+Description:
+
+	Refactorizes the basis from A and recomputes the values of the basic variables.
+	A warning is recorded if a basic variable's value changes (i.e., if the rounding
+	errors of the updates changed the answer) or if the basis could not be refactorized.
+*/
+func (s *revisedState) refactorize() {
+	// Setup
+	s.pivotsSinceRefactorization = 0
+	before := map[int]float64{}
+	for ii, bvIdx := range s.basis.BasicVariableIndicies {
+		before[bvIdx] = s.xBasic[ii]
+	}
+
+	err := s.basis.Refactorize()
+	if err != nil {
+		s.warnings = append(s.warnings, fmt.Sprintf(
+			"the basis could not be refactorized at iteration %v: %v",
+			s.iterations,
+			err,
+		))
+		return
+	}
+	s.statistics.Refactorizations++
+	s.xBasic = s.basicSolution()
+
+	// Compare the values of the basic variables
+	change := 0.0
+	for ii, bvIdx := range s.basis.BasicVariableIndicies {
+		scale := max(1.0, math.Abs(before[bvIdx]))
+		change = max(change, math.Abs(s.xBasic[ii]-before[bvIdx])/scale)
+	}
+	if change > refactorizationTolerance {
+		s.warnings = append(s.warnings, fmt.Sprintf(
+			"refactorizing the basis at iteration %v changed the basic solution by %.3g (relative)",
+			s.iterations,
+			change,
+		))
+	}
+}
+
+/*
+basicSolution
+Description:
+
+	Returns the values B^{-1} b of the basic variables.
+*/
+func (s *revisedState) basicSolution() []float64 {
+	out := slices.Clone(s.standardForm.B)
+	s.basis.FTRAN(out)
+	return out
+}
+
+/*
+toSolution
+Description:
+
+	Converts the current basic solution into a SimplexSolution of the original problem.
+*/
+func (s *revisedState) toSolution(condition tableau_termination.TerminationType, prob *problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
+	// Setup
+	_, nCols := s.standardForm.A.Dims()
+	x := make([]float64, nCols)
+	for ii, bvIdx := range s.basis.BasicVariableIndicies {
+		x[bvIdx] = s.xBasic[ii]
+	}
+
+	values, err := s.standardForm.ValuesOf(x, s.varMap)
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, fmt.Errorf(
+			"There was an issue creating the optimal values map at termination: %v",
+			err,
+		)
+	}
+
+	// Assemble the solution
+	sol := simplex_solution.SimplexSolution{
+		VariableValues:  values,
+		Status:          condition.ToOptimizationStatus(),
+		Iterations:      s.iterations,
+		Statistics:      s.statistics,
+		Warnings:        slices.Clone(s.warnings),
+		OriginalProblem: prob,
+	}
+	err = sol.EvaluateAtVariableValues()
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, fmt.Errorf(
+			"There was an issue evaluating the objective and constraints at termination: %v",
+			err,
+		)
+	}
+
+	return sol, nil
+}
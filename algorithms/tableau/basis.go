@@ -0,0 +1,130 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+// activityTolerance is the relative tolerance used to decide whether a constraint is active.
+const activityTolerance = 1e-9
+
+/*
+basisOf
+Description:
+
+	Describes the current basis in terms of the original problem, given the values
+	of the original variables at the current state:
+	- A variable is basic if (a part of) its standard form column is basic. Otherwise
+	  it is zero and it is at its lower bound (if the lower bound is 0), at its upper
+	  bound (if the upper bound is 0) or free.
+	- A constraint whose row is part of the standard form is basic if its slack is
+	  basic. Otherwise it is at its upper limit (<= constraints) or at its lower limit
+	  (>= and == constraints). The status of a constraint that does not appear in the
+	  standard form (e.g., x >= 0) is derived in the same way from whether it is active.
+	The result can be used as TableauAlgorithm.InitialBasis of a later solve.
+	Returns an error if the slack basis of the initial tableau does not fit its rows
+	(NewIterator rejects such problems, e.g., problems with equality constraints).
+*/
+func (it *TableauAlgorithmIterator) basisOf(values map[uint64]float64) (*simplex_solution.Basis, error) {
+	// Input Processing
+	// (the slack basis of a problem with equality constraints has fewer basic
+	// variables than rows, so it is not a basis of the tableau and neither is
+	// any basis that the algorithm reaches from it)
+	nRows, _ := it.initialTableau.AsCompressedMatrix.Dims()
+	if len(it.initialTableau.BasicVariableIndicies) != nRows-1 {
+		return nil, fmt.Errorf(
+			"basisOf: the initial tableau has %v basic variables for %v constraint rows",
+			len(it.initialTableau.BasicVariableIndicies),
+			nRows-1,
+		)
+	}
+
+	// Setup
+	finalTableau := it.State.Tableau
+	isBasic := map[int]bool{}
+	for _, idx := range finalTableau.BasicVariableIndicies {
+		isBasic[idx] = true
+	}
+
+	basis := &simplex_solution.Basis{VariableStatus: map[uint64]simplex_solution.BasisStatus{}}
+
+	// Variables
+	for _, v := range it.originalProblem.Variables {
+		positiveIdx, negativeIdx, err := it.standardFormColumnsOf(v)
+		if err != nil {
+			return nil, fmt.Errorf("basisOf: %v", err)
+		}
+
+		switch {
+		case (positiveIdx != -1 && isBasic[positiveIdx]) || (negativeIdx != -1 && isBasic[negativeIdx]):
+			basis.VariableStatus[v.ID] = simplex_solution.BasisStatusBasic
+		case v.Lower == 0:
+			basis.VariableStatus[v.ID] = simplex_solution.BasisStatusAtLower
+		case v.Upper == 0:
+			basis.VariableStatus[v.ID] = simplex_solution.BasisStatusAtUpper
+		default:
+			basis.VariableStatus[v.ID] = simplex_solution.BasisStatusFree
+		}
+	}
+
+	// Constraints
+	rows, _, err := it.constraintRows()
+	if err != nil {
+		return nil, fmt.Errorf("basisOf: %v", err)
+	}
+
+	constraints := utils.ExtractScalarConstraints(it.originalProblem.Constraints)
+	basis.ConstraintStatus = make([]simplex_solution.BasisStatus, len(constraints))
+	for kk, constraint := range constraints {
+		// Decide whether the constraint is active
+		var active bool
+		if rows[kk] != -1 {
+			active = !isBasic[it.initialTableau.BasicVariableIndicies[rows[kk]]]
+		} else {
+			active, err = it.isActive(constraint, values)
+			if err != nil {
+				return nil, fmt.Errorf("basisOf: %v", err)
+			}
+		}
+
+		switch {
+		case !active:
+			basis.ConstraintStatus[kk] = simplex_solution.BasisStatusBasic
+		case constraint.ConstrSense() == symbolic.SenseLessThanEqual:
+			basis.ConstraintStatus[kk] = simplex_solution.BasisStatusAtUpper
+		default:
+			basis.ConstraintStatus[kk] = simplex_solution.BasisStatusAtLower
+		}
+	}
+
+	return basis, nil
+}
+
+/*
+isActive
+Description:
+
+	Returns true if the two sides of the constraint are equal (up to activityTolerance)
+	when the original variables take the given values.
+*/
+func (it *TableauAlgorithmIterator) isActive(constraint symbolic.ScalarConstraint, values map[uint64]float64) (bool, error) {
+	// Setup
+	variables := it.originalProblem.Variables
+	expr, ok := constraint.Left().Minus(constraint.Right()).(symbolic.ScalarExpression)
+	if !ok {
+		return false, fmt.Errorf("the constraint %v is not a scalar constraint", constraint)
+	}
+
+	// Evaluate left - right
+	coeffs := expr.LinearCoeff(variables)
+	activity := expr.Constant()
+	for jj, v := range variables {
+		activity += coeffs.AtVec(jj) * values[v.ID]
+	}
+
+	return math.Abs(activity) <= activityTolerance*(1+math.Abs(expr.Constant())), nil
+}
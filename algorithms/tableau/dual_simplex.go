@@ -0,0 +1,146 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+	"slices"
+
+	tableau_termination "github.com/MatProGo-dev/simplex/algorithms/tableau/termination"
+)
+
+/*
+usesDualSimplex
+Description:
+
+	Returns true if the next pivots should be made by the dual simplex method.
+	This is the case after a modification that may have made the current basis
+	primal infeasible (see AddConstraint and SetConstraintRHS), as long as the
+	tableau is primal infeasible and dual feasible.
+*/
+func (it *TableauAlgorithmIterator) usesDualSimplex() bool {
+	if !it.dualSimplex {
+		return false
+	}
+	tableau := *it.State.Tableau
+	return !it.isPrimalFeasible(tableau) && it.isDualFeasible()
+}
+
+/*
+isDualFeasible
+Description:
+
+	Returns true if no entry of the objective row of the current tableau is negative
+	(up to the larger of the algorithm's optimality and pivot tolerances).
+*/
+func (it *TableauAlgorithmIterator) isDualFeasible() bool {
+	tolerance := max(it.Algorithm.OptimalityTolerance, it.Algorithm.GetPivotTolerance())
+	T := it.State.Tableau.AsCompressedMatrix
+	_, nCols := T.Dims()
+	for jj := 0; jj < nCols-1; jj++ {
+		if T.At(0, jj) < -tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+dualTermination
+Description:
+
+	Returns the termination condition of a state in which the dual simplex method is used:
+	the iteration limit, infeasibility (if the leaving row has no negative entry)
+	or DidNotTerminate.
+*/
+func (it *TableauAlgorithmIterator) dualTermination() (tableau_termination.TerminationType, error) {
+	// Input Checking
+	err := it.State.Check()
+	if err != nil {
+		return tableau_termination.DidNotTerminate, err
+	}
+
+	if it.State.IterationCount >= it.Algorithm.IterationLimit {
+		return tableau_termination.MaximumIterationsReached, nil
+	}
+
+	if _, _, err := it.selectDualPivot(); err != nil {
+		return tableau_termination.ProblemIsInfeasible, nil
+	}
+
+	return tableau_termination.DidNotTerminate, nil
+}
+
+/*
+selectDualPivot
+Description:
+
+	Selects the pivot of a dual simplex iteration using Bland's Rule:
+	- The exiting variable is the basic variable with the smallest index
+	  among those whose value is negative.
+	- The entering variable is the non-basic variable with the smallest ratio
+	  c_j / |a_rj| among those whose entry a_rj in the exiting variable's row
+	  is negative (the smallest index breaks ties).
+	Returns an error if the exiting variable's row has no negative entry,
+	in which case the problem is infeasible.
+*/
+func (it *TableauAlgorithmIterator) selectDualPivot() (int, int, error) {
+	// Setup
+	tableau := it.State.Tableau
+	tolerance := it.Algorithm.GetPivotTolerance()
+	T := tableau.AsCompressedMatrix
+	_, nCols := T.Dims()
+
+	// Select the exiting variable
+	rowIdx := -1
+	for ii, bvIdx := range tableau.BasicVariableIndicies {
+		if T.At(ii+1, nCols-1) >= -tolerance {
+			continue
+		}
+		if rowIdx == -1 || bvIdx < tableau.BasicVariableIndicies[rowIdx] {
+			rowIdx = ii
+		}
+	}
+	if rowIdx == -1 {
+		return -1, -1, fmt.Errorf("selectDualPivot: the tableau is primal feasible")
+	}
+	exitingVarIdx := tableau.BasicVariableIndicies[rowIdx]
+
+	// Select the entering variable
+	enteringVarIdx, bestRatio := -1, 0.0
+	for jj := 0; jj < nCols-1; jj++ {
+		a := T.At(rowIdx+1, jj)
+		if a >= -tolerance || slices.Contains(tableau.BasicVariableIndicies, jj) {
+			continue
+		}
+		if ratio := T.At(0, jj) / -a; enteringVarIdx == -1 || ratio < bestRatio {
+			enteringVarIdx, bestRatio = jj, ratio
+		}
+	}
+	if enteringVarIdx == -1 {
+		return -1, exitingVarIdx, fmt.Errorf(
+			"selectDualPivot: the row of %v has no negative entry, so the problem is infeasible",
+			tableau.Variables[exitingVarIdx],
+		)
+	}
+
+	return enteringVarIdx, exitingVarIdx, nil
+}
+
+/*
+calculateNextDualState
+Description:
+
+	Performs one pivot of the dual simplex method on the current state.
+*/
+func (it *TableauAlgorithmIterator) calculateNextDualState() (TableauAlgorithmState, error) {
+	enteringVarIdx, exitingVarIdx, err := it.selectDualPivot()
+	if err != nil {
+		return TableauAlgorithmState{}, err
+	}
+
+	nextState, err := it.pivotState(enteringVarIdx, exitingVarIdx)
+	if err != nil {
+		return TableauAlgorithmState{}, fmt.Errorf("TableauAlgorithmIterator: Failed to pivot tableau (%v)", err)
+	}
+
+	return nextState, nil
+}
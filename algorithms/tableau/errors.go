@@ -1,5 +1,7 @@
 package tableau_algorithm1
 
+import "fmt"
+
 type VariableSelectionError struct {
 	EnteringVarIndex int
 	ExitingVarIndex  int
@@ -16,3 +18,92 @@ func (e VariableSelectionError) Error() string {
 
 	return "VariableSelectionError: Unknown variable selection error."
 }
+
+/*
+PivotViolation
+Description:
+
+	Describes why a user-chosen pivot is not a valid simplex pivot.
+*/
+type PivotViolation string
+
+const (
+	EnteringVariableIsBasic   PivotViolation = "Entering Variable Is Basic"
+	ExitingVariableIsNotBasic PivotViolation = "Exiting Variable Is Not Basic"
+	ReducedCostIsNotImproving PivotViolation = "Reduced Cost Is Not Improving"
+	PivotElementIsNotPositive PivotViolation = "Pivot Element Is Not Positive"
+	MinimumRatioTestViolated  PivotViolation = "Minimum Ratio Test Violated"
+)
+
+/*
+InvalidPivotError
+Description:
+
+	A structured explanation of why a user-chosen pivot was rejected.
+	The Suggested* fields contain the choice that the configured selection
+	rule would have made from the same tableau (-1 and "" if the rule could
+	not make a choice).
+*/
+type InvalidPivotError struct {
+	Violation PivotViolation
+
+	EnteringVarIndex int
+	EnteringVarName  string
+	ExitingVarIndex  int
+	ExitingVarName   string
+
+	ReducedCost  float64 // The objective row entry of the entering variable
+	PivotElement float64 // The entry of the entering column in the exiting variable's row
+	Ratio        float64 // The ratio b_i / a_ie of the exiting variable's row
+	MinimumRatio float64 // The smallest ratio b_i / a_ie over all rows with a_ie > 0
+
+	SuggestedEnteringVarIndex int
+	SuggestedEnteringVarName  string
+	SuggestedExitingVarIndex  int
+	SuggestedExitingVarName   string
+}
+
+func (e InvalidPivotError) Error() string {
+	// Describe the violation
+	var reason string
+	switch e.Violation {
+	case EnteringVariableIsBasic:
+		reason = fmt.Sprintf("the entering variable %v is already basic", e.EnteringVarName)
+	case ExitingVariableIsNotBasic:
+		reason = fmt.Sprintf("the exiting variable %v is not basic", e.ExitingVarName)
+	case ReducedCostIsNotImproving:
+		reason = fmt.Sprintf(
+			"the reduced cost of the entering variable %v is %v, which does not improve the objective",
+			e.EnteringVarName,
+			e.ReducedCost,
+		)
+	case PivotElementIsNotPositive:
+		reason = fmt.Sprintf(
+			"the pivot element in column %v and the row of %v is %v, which is not positive",
+			e.EnteringVarName,
+			e.ExitingVarName,
+			e.PivotElement,
+		)
+	case MinimumRatioTestViolated:
+		reason = fmt.Sprintf(
+			"the ratio for the row of %v is %v, but the minimum ratio is %v",
+			e.ExitingVarName,
+			e.Ratio,
+			e.MinimumRatio,
+		)
+	default:
+		reason = "unknown violation"
+	}
+
+	// Describe the suggestion
+	suggestion := "the selection rule could not choose a pivot"
+	if e.SuggestedEnteringVarIndex != -1 && e.SuggestedExitingVarIndex != -1 {
+		suggestion = fmt.Sprintf(
+			"the selection rule would have chosen %v to enter and %v to exit",
+			e.SuggestedEnteringVarName,
+			e.SuggestedExitingVarName,
+		)
+	}
+
+	return fmt.Sprintf("InvalidPivotError: %v; %v.", reason, suggestion)
+}
@@ -0,0 +1,109 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+PivotBetween
+Description:
+
+	Identifies the pivot that transformed the state `before` into the state `after`
+	by comparing their basic variables. Returns the indices (in tableau.Variables)
+	of the entering and exiting variables.
+*/
+func PivotBetween(before TableauAlgorithmState, after TableauAlgorithmState) (int, int, error) {
+	// Input Processing
+	if len(before.Tableau.BasicVariableIndicies) != len(after.Tableau.BasicVariableIndicies) {
+		return -1, -1, fmt.Errorf(
+			"PivotBetween: the states have a different number of basic variables (%v and %v)",
+			len(before.Tableau.BasicVariableIndicies),
+			len(after.Tableau.BasicVariableIndicies),
+		)
+	}
+
+	// Find the row whose basic variable changed
+	enteringVarIdx, exitingVarIdx := -1, -1
+	for ii, bvIdx := range before.Tableau.BasicVariableIndicies {
+		if after.Tableau.BasicVariableIndicies[ii] == bvIdx {
+			continue
+		}
+
+		if enteringVarIdx != -1 {
+			return -1, -1, fmt.Errorf("PivotBetween: the states differ by more than one pivot")
+		}
+		enteringVarIdx = after.Tableau.BasicVariableIndicies[ii]
+		exitingVarIdx = bvIdx
+	}
+
+	if enteringVarIdx == -1 {
+		return -1, -1, fmt.Errorf("PivotBetween: the states have the same basic variables")
+	}
+
+	return enteringVarIdx, exitingVarIdx, nil
+}
+
+/*
+HistoryToLaTeX
+Description:
+
+	Renders a sequence of states (e.g., TableauAlgorithmIterator.History) as a sequence of
+	LaTeX tableaus. The pivot element of each tableau is highlighted and each pair of
+	consecutive tableaus is separated by a sentence naming the entering and exiting variables.
+	Returns an error if one of the tableaus is not valid.
+*/
+func HistoryToLaTeX(history []TableauAlgorithmState) (string, error) {
+	// Setup
+	var sb strings.Builder
+
+	for ii, state := range history {
+		// Check that the tableau can be rendered
+		err := state.Tableau.Check()
+		if err != nil {
+			return "", fmt.Errorf("HistoryToLaTeX: the tableau of iteration %v is invalid (%v)", state.IterationCount, err)
+		}
+
+		// Find the pivot applied to this state (if any)
+		enteringVarIdx, exitingVarIdx := -1, -1
+		if ii < len(history)-1 {
+			var err error
+			enteringVarIdx, exitingVarIdx, err = PivotBetween(state, history[ii+1])
+			if err != nil {
+				return "", fmt.Errorf("HistoryToLaTeX: could not find the pivot after iteration %v (%v)", state.IterationCount, err)
+			}
+		}
+
+		// Render the tableau
+		sb.WriteString(fmt.Sprintf("%% Iteration %v\n", state.IterationCount))
+		sb.WriteString("\\begin{center}\n")
+		sb.WriteString(state.Tableau.ToLaTeXWithPivot(enteringVarIdx, exitingVarIdx))
+		sb.WriteString("\\end{center}\n")
+
+		// Annotate the pivot
+		if enteringVarIdx != -1 {
+			sb.WriteString(
+				fmt.Sprintf(
+					"\\noindent Iteration %v: %v enters the basis and %v leaves the basis.\n\n",
+					history[ii+1].IterationCount,
+					utils.LaTeXVariableName(state.Tableau.Variables[enteringVarIdx].Name),
+					utils.LaTeXVariableName(state.Tableau.Variables[exitingVarIdx].Name),
+				),
+			)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+/*
+ToLaTeX
+Description:
+
+	Renders all of the states visited by the iterator so far as LaTeX (see HistoryToLaTeX).
+*/
+func (it *TableauAlgorithmIterator) ToLaTeX() (string, error) {
+	return HistoryToLaTeX(it.History)
+}
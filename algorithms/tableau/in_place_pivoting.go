@@ -0,0 +1,110 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+
+	"github.com/MatProGo-dev/simplex/algorithms/tableau/selection"
+	"github.com/MatProGo-dev/simplex/utils"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+calculateNextPrimalState
+Description:
+
+	Performs one pivot of the primal simplex method on the current state, using the
+	algorithm's selection rule (see TableauAlgorithmState.CalculateNextStateUsing).
+*/
+func (it *TableauAlgorithmIterator) calculateNextPrimalState() (TableauAlgorithmState, error) {
+	// Without in-place pivoting, the state creates the new tableau
+	if !it.Algorithm.InPlacePivoting {
+		return it.State.CalculateNextStateUsing(it.Algorithm.GetSelectionRule())
+	}
+
+	// Select the pivot column and row (i.e., the entering and exiting variables in the tableau)
+	// (the default Bland's Rule is called directly, because converting it to a SelectionRule allocates)
+	var enteringVarIdx, exitingVarIdx int
+	var err error
+	if it.Algorithm.SelectionRule == nil {
+		rule := selection.BlandsRule{Tolerance: it.Algorithm.GetPivotTolerance()}
+		enteringVarIdx, exitingVarIdx, err = rule.SelectEnteringAndExitingVariables(*it.State.Tableau)
+	} else {
+		enteringVarIdx, exitingVarIdx, err = it.Algorithm.SelectionRule.SelectEnteringAndExitingVariables(*it.State.Tableau)
+	}
+	if err != nil {
+		return TableauAlgorithmState{}, VariableSelectionError{EnteringVarIndex: enteringVarIdx, ExitingVarIndex: exitingVarIdx}
+	}
+
+	nextState, err := it.pivotState(enteringVarIdx, exitingVarIdx)
+	if err != nil {
+		return TableauAlgorithmState{}, fmt.Errorf("TableauAlgorithmIterator: Failed to pivot tableau (%v)", err)
+	}
+	return nextState, nil
+}
+
+/*
+pivotState
+Description:
+
+	Returns the state after a pivot of the current tableau on the given entering and
+	exiting variables. With the algorithm's InPlacePivoting, the pivot is made in place
+	on the iterator's workspace, which is first overwritten with the current tableau if
+	the current tableau is a different one (e.g., the initial tableau or a refactorized
+	tableau); otherwise a new tableau is created (see utils.Tableau.Pivot).
+*/
+func (it *TableauAlgorithmIterator) pivotState(enteringVarIdx int, exitingVarIdx int) (TableauAlgorithmState, error) {
+	// Without in-place pivoting, create a new tableau
+	if !it.Algorithm.InPlacePivoting {
+		newTab, err := it.State.Tableau.Pivot(enteringVarIdx, exitingVarIdx)
+		if err != nil {
+			return TableauAlgorithmState{}, err
+		}
+		return TableauAlgorithmState{
+			Tableau:        &newTab,
+			IterationCount: it.State.IterationCount + 1,
+		}, nil
+	}
+
+	// Otherwise, pivot the workspace
+	if it.workspace != it.State.Tableau {
+		it.workspace = copyTableauInto(it.workspace, *it.State.Tableau)
+	}
+	err := it.workspace.PivotInPlace(enteringVarIdx, exitingVarIdx)
+	if err != nil {
+		return TableauAlgorithmState{}, err
+	}
+
+	return TableauAlgorithmState{
+		Tableau:        it.workspace,
+		IterationCount: it.State.IterationCount + 1,
+	}, nil
+}
+
+/*
+copyTableauInto
+Description:
+
+	Copies the tableau src into dst, reusing dst's memory if it has the same size,
+	and returns dst (or a new tableau if dst is nil or has a different size).
+*/
+func copyTableauInto(dst *utils.Tableau, src utils.Tableau) *utils.Tableau {
+	// Allocate a new tableau if dst cannot hold src
+	if dst == nil || len(dst.BasicVariableIndicies) != len(src.BasicVariableIndicies) {
+		return &utils.Tableau{
+			Variables:             src.Variables,
+			BasicVariableIndicies: append([]int{}, src.BasicVariableIndicies...),
+			AsCompressedMatrix:    mat.DenseCopyOf(src.AsCompressedMatrix),
+		}
+	}
+	dstRows, dstCols := dst.AsCompressedMatrix.Dims()
+	srcRows, srcCols := src.AsCompressedMatrix.Dims()
+	if dstRows != srcRows || dstCols != srcCols {
+		return copyTableauInto(nil, src)
+	}
+
+	// Otherwise, overwrite dst
+	dst.Variables = src.Variables
+	copy(dst.BasicVariableIndicies, src.BasicVariableIndicies)
+	dst.AsCompressedMatrix.Copy(src.AsCompressedMatrix)
+	return dst
+}
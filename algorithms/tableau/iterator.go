@@ -0,0 +1,359 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	tableau_termination "github.com/MatProGo-dev/simplex/algorithms/tableau/termination"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+TableauAlgorithmIterator
+Description:
+
+	Drives the tableau algorithm one pivot at a time.
+	Instead of running the whole loop in TableauAlgorithm.Solve, the user
+	calls Next() (or NextWithPivot()) repeatedly until Terminated() returns true,
+	and then calls Finish() to produce the SimplexSolution.
+*/
+type TableauAlgorithmIterator struct {
+	Algorithm *TableauAlgorithm
+	State     TableauAlgorithmState
+	History   []TableauAlgorithmState
+
+	// Information needed to translate the final state back to the original problem
+	// (initialTableau is the tableau of the slack basis, even after a warm start;
+	// if the problem was scaled, it is scaled and unscaledTableau is the original one)
+	initialTableau                                  *utils.Tableau
+	unscaledTableau                                 *utils.Tableau
+	scaling                                         *scaling
+	originalProblem                                 *problem.OptimizationProblem
+	mapFromOriginalVariablesToStandardFormVariables map[symbolic.Variable]symbolic.Expression
+
+	// dualSimplex is set by the modifications that can make the current basis
+	// primal infeasible (see usesDualSimplex)
+	dualSimplex bool
+
+	// Statistics of the pivots made so far (completed by statisticsAtFinish);
+	// initialMagnitude and maxMagnitude are the largest magnitudes of an entry of
+	// the initial tableau and of any tableau that was visited
+	started          time.Time
+	statistics       simplex_solution.Statistics
+	initialMagnitude float64
+	maxMagnitude     float64
+
+	// pivotsSinceRefactorization counts the pivots since the tableau was last recomputed
+	// from the initial tableau and warnings collects the numerical problems that were
+	// found (see checkNumericalStability)
+	pivotsSinceRefactorization int
+	warnings                   []string
+
+	// workspace is the tableau that is pivoted in place when the algorithm's
+	// InPlacePivoting is set (see pivotState)
+	workspace *utils.Tableau
+}
+
+/*
+NewIterator
+Description:
+
+	Creates an iterator whose initial state is built from the initial tableau of
+	the given problem (or from the algorithm's InitialBasis, if it is set).
+	Returns an error if the slack basis of the initial tableau is not a feasible basis
+	(see utils.Tableau.CheckSlackBasis), e.g., for problems with equality constraints.
+	The pivots needed to reach InitialBasis are not counted as iterations.
+	If the algorithm's Scaling is set, the pivots are made on the scaled tableau.
+*/
+func (algo *TableauAlgorithm) NewIterator(prob problem.OptimizationProblem) (*TableauAlgorithmIterator, error) {
+	// Setup
+	started := time.Now()
+
+	// Create initial Tableau state from the problem
+	initialTableau, mapFromOriginalVariablesToStandardFormVariables, err := utils.GetInitialTableauFrom(&prob)
+	if err != nil {
+		return nil, fmt.Errorf("there was an issue creating the initial tableau: %v", err)
+	}
+
+	// Verify that the pivots can start from the slack basis
+	// (without a phase I, the rows of equality and >= constraints have no valid slack)
+	err = initialTableau.CheckSlackBasis()
+	if err != nil {
+		return nil, fmt.Errorf("TableauAlgorithm: %v", err)
+	}
+
+	// Scale the standard form
+	unscaledTableau := initialTableau
+	sc, err := newScaling(algo.Scaling, initialTableau)
+	if err != nil {
+		return nil, err
+	}
+	if sc != nil {
+		initialTableau = sc.apply(initialTableau)
+	}
+
+	state0 := TableauAlgorithmState{
+		Tableau:        &initialTableau,
+		IterationCount: 0,
+	}
+
+	it := &TableauAlgorithmIterator{
+		Algorithm:       algo,
+		State:           state0,
+		History:         []TableauAlgorithmState{state0},
+		initialTableau:  &initialTableau,
+		unscaledTableau: &unscaledTableau,
+		scaling:         sc,
+		originalProblem: &prob,
+		mapFromOriginalVariablesToStandardFormVariables: mapFromOriginalVariablesToStandardFormVariables,
+		started:          started,
+		initialMagnitude: largestMagnitude(initialTableau),
+	}
+	it.maxMagnitude = it.initialMagnitude
+
+	// Start from the user's basis
+	if algo.InitialBasis != nil {
+		err = it.warmStart(algo.InitialBasis)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return it, nil
+}
+
+/*
+Termination
+Description:
+
+	Returns the termination condition of the current state.
+	If the algorithm should continue, then tableau_termination.DidNotTerminate is returned.
+	While the dual simplex method is used (after AddConstraint or SetConstraintRHS),
+	the problem can also be found to be infeasible.
+*/
+func (it *TableauAlgorithmIterator) Termination() (tableau_termination.TerminationType, error) {
+	if it.usesDualSimplex() {
+		return it.dualTermination()
+	}
+	return it.Algorithm.CheckTerminationConditions(it.State)
+}
+
+/*
+Terminated
+Description:
+
+	Returns true if the current state satisfies one of the termination conditions
+	of the algorithm (or if the termination conditions could not be evaluated).
+*/
+func (it *TableauAlgorithmIterator) Terminated() bool {
+	condition, err := it.Termination()
+	if err != nil {
+		return true
+	}
+	return condition != tableau_termination.DidNotTerminate
+}
+
+/*
+Next
+Description:
+
+	Performs exactly one pivot using the algorithm's selection rule and
+	returns the new state. After a modification that made the current basis
+	primal infeasible, the pivot is a dual simplex pivot (see selectDualPivot).
+*/
+func (it *TableauAlgorithmIterator) Next() (TableauAlgorithmState, error) {
+	// Input Checking
+	err := it.checkCanContinue()
+	if err != nil {
+		return it.State, err
+	}
+
+	// Update the state
+	started := time.Now()
+	objectiveBefore := it.State.Tableau.D()
+	phase := simplex_solution.PhasePrimalSimplex
+	var nextState TableauAlgorithmState
+	if it.usesDualSimplex() {
+		phase = simplex_solution.PhaseDualSimplex
+		nextState, err = it.calculateNextDualState()
+	} else {
+		nextState, err = it.calculateNextPrimalState()
+	}
+	if err != nil {
+		return it.State, fmt.Errorf(
+			"There was an issue updating the state at iteration %v: %v",
+			it.State.IterationCount,
+			err,
+		)
+	}
+
+	return it.advanceTo(nextState, phase, objectiveBefore, started), nil
+}
+
+/*
+NextWithPivot
+Description:
+
+	Performs exactly one pivot where the entering and exiting variables are chosen by the user.
+	Both indices refer to positions in the tableau's Variables slice.
+*/
+func (it *TableauAlgorithmIterator) NextWithPivot(enteringVarIdx int, exitingVarIdx int) (TableauAlgorithmState, error) {
+	// Input Checking
+	err := it.checkCanContinue()
+	if err != nil {
+		return it.State, err
+	}
+
+	// Create the new tableau
+	started := time.Now()
+	objectiveBefore := it.State.Tableau.D()
+	nextState, err := it.pivotState(enteringVarIdx, exitingVarIdx)
+	if err != nil {
+		return it.State, fmt.Errorf("TableauAlgorithmIterator: Failed to pivot tableau (%v)", err)
+	}
+
+	return it.advanceTo(nextState, simplex_solution.PhaseManual, objectiveBefore, started), nil
+}
+
+/*
+Reoptimize
+Description:
+
+	Pivots until the algorithm terminates and returns the solution (see Finish).
+	This solves the problem when it is called on a new iterator and re-solves it
+	from the current tableau after the problem was modified (see AddConstraint,
+	AddVariable, SetObjectiveCoefficient and SetConstraintRHS). The pivots of all
+	solves count towards the algorithm's IterationLimit.
+*/
+func (it *TableauAlgorithmIterator) Reoptimize() (simplex_solution.SimplexSolution, error) {
+	for !it.Terminated() {
+		_, err := it.Next()
+		if err != nil {
+			return simplex_solution.SimplexSolution{}, err
+		}
+	}
+
+	return it.Finish()
+}
+
+/*
+Finish
+Description:
+
+	Converts the current state into a SimplexSolution.
+	If the algorithm has not terminated yet, the solution's status will reflect that.
+	The solution includes the current basis (which can warm start a later solve) and,
+	when they can be computed, its dual values and reduced costs (see DualValues and ReducedCosts).
+*/
+func (it *TableauAlgorithmIterator) Finish() (simplex_solution.SimplexSolution, error) {
+	// Evaluate the termination condition
+	condition, err := it.Termination()
+	if err != nil {
+		return simplex_solution.SimplexSolution{},
+			fmt.Errorf(
+				"There was an issue checking the termination condition at iteration %v: %v",
+				it.State.IterationCount,
+				err,
+			)
+	}
+
+	return it.FinishWith(condition)
+}
+
+/*
+FinishWith
+Description:
+
+	Same as Finish, but the solution's status is given by the provided termination
+	condition instead of the algorithm's termination conditions (e.g., when the
+	iterations were stopped because a deadline passed).
+*/
+func (it *TableauAlgorithmIterator) FinishWith(condition tableau_termination.TerminationType) (simplex_solution.SimplexSolution, error) {
+	// Convert the final state to a solution (in terms of the unscaled problem)
+	finalState := it.State
+	if it.scaling != nil {
+		unscaled := it.scaling.unscale(*it.State.Tableau)
+		finalState.Tableau = &unscaled
+	}
+	sol, err := finalState.ToSolution(condition, it.mapFromOriginalVariablesToStandardFormVariables, it.originalProblem)
+	if err != nil {
+		return simplex_solution.SimplexSolution{},
+			fmt.Errorf(
+				"There was an issue converting the final state to a solution at iteration %v: %v",
+				it.State.IterationCount,
+				err,
+			)
+	}
+
+	// Attach the statistics, the warnings, the final basis and the sensitivity information
+	sol.Statistics = it.statisticsAtFinish()
+	sol.Warnings = slices.Clone(it.warnings)
+
+	sol.Basis, err = it.basisOf(sol.VariableValues)
+	if err != nil {
+		sol.Warnings = append(sol.Warnings, fmt.Sprintf("the final basis could not be described: %v", err))
+	}
+
+	duals, err := it.DualValues()
+	if err == nil {
+		sol.ReducedCosts, err = it.ReducedCosts(duals)
+	}
+	if err == nil {
+		sol.DualValues = duals
+	}
+
+	return sol, nil
+}
+
+/*
+checkCanContinue
+Description:
+
+	Returns an error if the iterator is not allowed to perform another pivot.
+*/
+func (it *TableauAlgorithmIterator) checkCanContinue() error {
+	condition, err := it.Termination()
+	if err != nil {
+		return fmt.Errorf(
+			"There was an issue checking the termination condition at iteration %v: %v",
+			it.State.IterationCount,
+			err,
+		)
+	}
+
+	if condition != tableau_termination.DidNotTerminate {
+		return fmt.Errorf(
+			"TableauAlgorithmIterator: the algorithm has already terminated (%v) at iteration %v",
+			condition,
+			it.State.IterationCount,
+		)
+	}
+
+	return nil
+}
+
+/*
+advanceTo
+Description:
+
+	Makes nextState the current state of the iterator, records it in the history and
+	adds the pivot (of the given phase, which started at the given time from a tableau
+	with the given objective value) to the statistics. With in-place pivoting, the history
+	only keeps the current state. The new tableau is recomputed from the initial tableau
+	if a refactorization is due (see checkNumericalStability).
+*/
+func (it *TableauAlgorithmIterator) advanceTo(nextState TableauAlgorithmState, phase string, objectiveBefore float64, started time.Time) TableauAlgorithmState {
+	it.recordPivot(phase, objectiveBefore, *nextState.Tableau, started)
+	it.State = nextState
+	if it.Algorithm.InPlacePivoting {
+		it.History = append(it.History[:0], nextState)
+	} else {
+		it.History = append(it.History, nextState)
+	}
+	it.checkNumericalStability()
+	return it.State
+}
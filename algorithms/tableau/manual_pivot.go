@@ -0,0 +1,181 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+ValidatePivot
+Description:
+
+	Checks whether or not pivoting on (enteringVarIdx, exitingVarIdx) is a valid
+	simplex pivot for the given tableau. Specifically, we check that:
+	- The entering variable is non-basic and the exiting variable is basic,
+	- The entering variable's reduced cost is negative (i.e., it improves the objective),
+	- The pivot element is positive, and
+	- The exiting variable's row attains the minimum ratio b_i / a_ie.
+	If any of these checks fail, an InvalidPivotError is returned that also contains
+	the pivot that the algorithm's selection rule would have chosen.
+*/
+func (algo *TableauAlgorithm) ValidatePivot(tableau utils.Tableau, enteringVarIdx int, exitingVarIdx int) error {
+	// Input Processing
+	err := tableau.Check()
+	if err != nil {
+		return fmt.Errorf("ValidatePivot: %v", err)
+	}
+
+	nVariables := len(tableau.Variables)
+	if enteringVarIdx < 0 || enteringVarIdx >= nVariables {
+		return fmt.Errorf("ValidatePivot: the entering variable index %v is outside of the range [0,%v]", enteringVarIdx, nVariables-1)
+	}
+	if exitingVarIdx < 0 || exitingVarIdx >= nVariables {
+		return fmt.Errorf("ValidatePivot: the exiting variable index %v is outside of the range [0,%v]", exitingVarIdx, nVariables-1)
+	}
+
+	// Setup
+	pivotErr := algo.newInvalidPivotError(tableau, enteringVarIdx, exitingVarIdx)
+	pivotTolerance := algo.GetPivotTolerance()
+
+	// Check that the entering variable is not basic and that the exiting variable is basic
+	if foundIdx, _ := symbolic.FindInSlice(enteringVarIdx, tableau.BasicVariableIndicies); foundIdx != -1 {
+		pivotErr.Violation = EnteringVariableIsBasic
+		return pivotErr
+	}
+
+	exitingRow, _ := symbolic.FindInSlice(exitingVarIdx, tableau.BasicVariableIndicies)
+	if exitingRow == -1 {
+		pivotErr.Violation = ExitingVariableIsNotBasic
+		return pivotErr
+	}
+
+	// Collect the relevant values from the tableau
+	A, b, c := tableau.A(), tableau.B(), tableau.C()
+	pivotErr.ReducedCost = c.AtVec(enteringVarIdx)
+	pivotErr.PivotElement = A.At(exitingRow, enteringVarIdx)
+	pivotErr.Ratio = b.AtVec(exitingRow) / pivotErr.PivotElement
+
+	pivotErr.MinimumRatio = math.Inf(1)
+	for ii := 0; ii < tableau.NumberOfConstraints(); ii++ {
+		if A.At(ii, enteringVarIdx) > pivotTolerance {
+			pivotErr.MinimumRatio = math.Min(pivotErr.MinimumRatio, b.AtVec(ii)/A.At(ii, enteringVarIdx))
+		}
+	}
+
+	// Check the reduced cost
+	if pivotErr.ReducedCost >= -pivotTolerance {
+		pivotErr.Violation = ReducedCostIsNotImproving
+		return pivotErr
+	}
+
+	// Check the pivot element
+	if pivotErr.PivotElement <= pivotTolerance {
+		pivotErr.Violation = PivotElementIsNotPositive
+		return pivotErr
+	}
+
+	// Check the minimum ratio test
+	if pivotErr.Ratio > pivotErr.MinimumRatio+pivotTolerance {
+		pivotErr.Violation = MinimumRatioTestViolated
+		return pivotErr
+	}
+
+	// All Checks Passed!
+	return nil
+}
+
+/*
+ApplyManualPivot
+Description:
+
+	Applies the pivot chosen by the user (by variable name) to the given tableau.
+	The pivot is validated with ValidatePivot first; if it is invalid, then the
+	tableau is left untouched and an InvalidPivotError is returned.
+*/
+func (algo *TableauAlgorithm) ApplyManualPivot(tableau utils.Tableau, enteringVarName string, exitingVarName string) (utils.Tableau, error) {
+	// Find the variables
+	enteringVarIdx, err := tableau.VariableIndex(enteringVarName)
+	if err != nil {
+		return utils.Tableau{}, fmt.Errorf("ApplyManualPivot: could not find the entering variable (%v)", err)
+	}
+
+	exitingVarIdx, err := tableau.VariableIndex(exitingVarName)
+	if err != nil {
+		return utils.Tableau{}, fmt.Errorf("ApplyManualPivot: could not find the exiting variable (%v)", err)
+	}
+
+	// Validate the pivot
+	err = algo.ValidatePivot(tableau, enteringVarIdx, exitingVarIdx)
+	if err != nil {
+		return utils.Tableau{}, err
+	}
+
+	// Pivot
+	return tableau.Pivot(enteringVarIdx, exitingVarIdx)
+}
+
+/*
+NextWithNamedPivot
+Description:
+
+	Performs exactly one pivot where the entering and exiting variables are chosen by the user
+	by name. Unlike NextWithPivot, the pivot is validated first; if it is invalid,
+	an InvalidPivotError is returned and the iterator's state is not changed.
+*/
+func (it *TableauAlgorithmIterator) NextWithNamedPivot(enteringVarName string, exitingVarName string) (TableauAlgorithmState, error) {
+	// Input Checking
+	err := it.checkCanContinue()
+	if err != nil {
+		return it.State, err
+	}
+
+	// Apply the pivot
+	started := time.Now()
+	objectiveBefore := it.State.Tableau.D()
+	newTab, err := it.Algorithm.ApplyManualPivot(*it.State.Tableau, enteringVarName, exitingVarName)
+	if err != nil {
+		return it.State, err
+	}
+
+	return it.advanceTo(TableauAlgorithmState{
+		Tableau:        &newTab,
+		IterationCount: it.State.IterationCount + 1,
+	}, simplex_solution.PhaseManual, objectiveBefore, started), nil
+}
+
+/*
+newInvalidPivotError
+Description:
+
+	Creates an InvalidPivotError for the given pivot that is already populated with
+	the variable names and the choice of the algorithm's selection rule.
+*/
+func (algo *TableauAlgorithm) newInvalidPivotError(tableau utils.Tableau, enteringVarIdx int, exitingVarIdx int) InvalidPivotError {
+	// Setup
+	out := InvalidPivotError{
+		EnteringVarIndex:          enteringVarIdx,
+		EnteringVarName:           tableau.Variables[enteringVarIdx].Name,
+		ExitingVarIndex:           exitingVarIdx,
+		ExitingVarName:            tableau.Variables[exitingVarIdx].Name,
+		SuggestedEnteringVarIndex: -1,
+		SuggestedExitingVarIndex:  -1,
+	}
+
+	// Ask the selection rule for its choice
+	suggestedEntering, suggestedExiting, err := algo.GetSelectionRule().SelectEnteringAndExitingVariables(tableau)
+	if err != nil || suggestedEntering == -1 || suggestedExiting == -1 {
+		return out
+	}
+
+	out.SuggestedEnteringVarIndex = suggestedEntering
+	out.SuggestedEnteringVarName = tableau.Variables[suggestedEntering].Name
+	out.SuggestedExitingVarIndex = suggestedExiting
+	out.SuggestedExitingVarName = tableau.Variables[suggestedExiting].Name
+
+	return out
+}
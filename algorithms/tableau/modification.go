@@ -0,0 +1,547 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/utils"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+AddConstraint
+Description:
+
+	Adds the constraint (a scalar or a vector constraint) to the problem and updates the
+	current tableau, so that Reoptimize continues from the current basis instead of
+	solving the modified problem from scratch. Each new row gets a slack variable that is
+	basic in the current basis. If the current solution violates the constraint, then the
+	dual simplex method is used until the basis is primal feasible again.
+	Only inequality constraints on the problem's variables can be added.
+	The history of the iterator is restarted from the modified tableau.
+*/
+func (it *TableauAlgorithmIterator) AddConstraint(constraint symbolic.Constraint) error {
+	// Input Processing
+	if err := it.checkIsUnscaled("AddConstraint"); err != nil {
+		return err
+	}
+	if !constraint.IsLinear() {
+		return fmt.Errorf("AddConstraint: the constraint %v is not linear", constraint)
+	}
+
+	scalarConstraints := utils.ExtractScalarConstraints([]symbolic.Constraint{constraint})
+	for _, scalarConstraint := range scalarConstraints {
+		if scalarConstraint.ConstrSense() == symbolic.SenseEqual {
+			return fmt.Errorf("AddConstraint: only inequality constraints can be added (add an equality as two inequalities)")
+		}
+		err := it.checkVariablesOf(scalarConstraint)
+		if err != nil {
+			return fmt.Errorf("AddConstraint: %v", err)
+		}
+	}
+
+	// Add the rows
+	initialTableau, currentTableau := *it.initialTableau, *it.State.Tableau
+	for _, scalarConstraint := range scalarConstraints {
+		var err error
+		initialTableau, currentTableau, err = it.withRow(initialTableau, currentTableau, scalarConstraint)
+		if err != nil {
+			return fmt.Errorf("AddConstraint: %v", err)
+		}
+	}
+
+	// Update the problem
+	it.originalProblem.Constraints = append(slices.Clip(it.originalProblem.Constraints), constraint)
+	it.replaceTableaus(initialTableau, currentTableau)
+	it.dualSimplex = true
+
+	return nil
+}
+
+/*
+AddVariable
+Description:
+
+	Adds a nonnegative continuous variable with the given objective coefficient to the
+	problem. coefficients contains the coefficient of the new variable in each scalar
+	constraint of the problem (in the order given by utils.ExtractScalarConstraints).
+	The variable's column is added to the current tableau, so that Reoptimize continues
+	from the current basis with the primal simplex method (the variable enters the basis
+	if its reduced cost is improving). Returns the new variable, which is also registered
+	with the environment of the problem's variables (e.g., the problem given to NewIterator).
+	The scalar constraints of the problem are rewritten to include the variable and the
+	history of the iterator is restarted from the modified tableau.
+*/
+func (it *TableauAlgorithmIterator) AddVariable(objectiveCoefficient float64, coefficients []float64) (symbolic.Variable, error) {
+	// Input Processing
+	if err := it.checkIsUnscaled("AddVariable"); err != nil {
+		return symbolic.Variable{}, err
+	}
+	scalarConstraints := utils.ExtractScalarConstraints(it.originalProblem.Constraints)
+	if len(coefficients) != len(scalarConstraints) {
+		return symbolic.Variable{}, fmt.Errorf(
+			"AddVariable: the problem has %v scalar constraints, but %v coefficients were given",
+			len(scalarConstraints),
+			len(coefficients),
+		)
+	}
+
+	objective, ok := it.originalProblem.Objective.Expression.(symbolic.ScalarExpression)
+	if !ok {
+		return symbolic.Variable{}, fmt.Errorf("AddVariable: the objective is not a scalar expression")
+	}
+
+	// Create the column of the initial tableau
+	rows, signs, err := it.constraintRows()
+	if err != nil {
+		return symbolic.Variable{}, fmt.Errorf("AddVariable: %v", err)
+	}
+
+	column := make([]float64, it.initialTableau.NumberOfConstraints()+1)
+	column[0] = -it.senseFactor() * objectiveCoefficient
+	for kk, a := range coefficients {
+		if a == 0 {
+			continue
+		}
+		if rows[kk] == -1 {
+			return symbolic.Variable{}, fmt.Errorf(
+				"AddVariable: the scalar constraint %v does not appear in the standard form, so the variable cannot be added to it",
+				kk,
+			)
+		}
+		column[rows[kk]+1] = signs[kk] * a
+	}
+
+	currentColumn, err := it.toCurrentTableau(column)
+	if err != nil {
+		return symbolic.Variable{}, fmt.Errorf("AddVariable: %v", err)
+	}
+
+	// Create the variables
+	v := nextVariable(it.originalProblem.Variables, "")
+	standardFormVariable := nextVariable(it.initialTableau.Variables, "")
+	variables := append(slices.Clip(it.initialTableau.Variables), standardFormVariable)
+
+	initialTableau := utils.Tableau{
+		Variables:             variables,
+		BasicVariableIndicies: it.initialTableau.BasicVariableIndicies,
+		AsCompressedMatrix:    insertColumn(it.initialTableau.AsCompressedMatrix, column),
+	}
+	currentTableau := utils.Tableau{
+		Variables:             variables,
+		BasicVariableIndicies: it.State.Tableau.BasicVariableIndicies,
+		AsCompressedMatrix:    insertColumn(it.State.Tableau.AsCompressedMatrix, currentColumn),
+	}
+
+	// Update the problem
+	constraints := make([]symbolic.Constraint, len(scalarConstraints))
+	for kk, scalarConstraint := range scalarConstraints {
+		constraints[kk] = scalarConstraint
+		if coefficients[kk] != 0 {
+			constraints[kk] = scalarConstraint.Left().Plus(v.Multiply(coefficients[kk])).Comparison(
+				scalarConstraint.Right(),
+				scalarConstraint.ConstrSense(),
+			)
+		}
+	}
+
+	err = it.originalProblem.SetObjective(objective.Plus(v.Multiply(objectiveCoefficient)), it.originalProblem.Objective.Sense)
+	if err != nil {
+		return symbolic.Variable{}, fmt.Errorf("AddVariable: %v", err)
+	}
+	it.originalProblem.Variables = append(slices.Clip(it.originalProblem.Variables), v)
+	it.originalProblem.Constraints = constraints
+	it.mapFromOriginalVariablesToStandardFormVariables[v] = standardFormVariable
+	it.replaceTableaus(initialTableau, currentTableau)
+
+	return v, nil
+}
+
+/*
+SetObjectiveCoefficient
+Description:
+
+	Changes the objective coefficient of the variable v to the given value and updates
+	the objective row of the current tableau, so that Reoptimize continues from the
+	current basis with the primal simplex method.
+	The history of the iterator is restarted from the modified tableau.
+*/
+func (it *TableauAlgorithmIterator) SetObjectiveCoefficient(v symbolic.Variable, value float64) error {
+	// Input Processing
+	if err := it.checkIsUnscaled("SetObjectiveCoefficient"); err != nil {
+		return err
+	}
+	expr, found := it.mapFromOriginalVariablesToStandardFormVariables[v]
+	if !found {
+		return fmt.Errorf("SetObjectiveCoefficient: %v is not a variable of the problem", v)
+	}
+	standardFormExpr, ok := expr.(symbolic.ScalarExpression)
+	if !ok {
+		return fmt.Errorf("SetObjectiveCoefficient: the variable %v was not replaced by a scalar expression", v)
+	}
+
+	objective, ok := it.originalProblem.Objective.Expression.(symbolic.ScalarExpression)
+	if !ok {
+		return fmt.Errorf("SetObjectiveCoefficient: the objective is not a scalar expression")
+	}
+
+	current := objective.LinearCoeff([]symbolic.Variable{v})
+	delta := value - current.AtVec(0)
+	if delta == 0 {
+		return nil
+	}
+
+	// Compute the change of the objective row (which contains -c^T and -d of the standard form)
+	coeffs := standardFormExpr.LinearCoeff(it.initialTableau.Variables)
+	change := make([]float64, coeffs.Len()+1)
+	for jj := 0; jj < coeffs.Len(); jj++ {
+		change[jj] = -it.senseFactor() * delta * coeffs.AtVec(jj)
+	}
+	change[coeffs.Len()] = -it.senseFactor() * delta * standardFormExpr.Constant()
+
+	// Update the tableaus
+	initialTableau, currentTableau := *it.initialTableau, *it.State.Tableau
+	initialTableau.AsCompressedMatrix = addToObjectiveRow(initialTableau.AsCompressedMatrix, change)
+	currentTableau.AsCompressedMatrix = addToObjectiveRow(currentTableau.AsCompressedMatrix, change)
+
+	// The objective row of the current tableau must be zero in the basic columns
+	T := currentTableau.AsCompressedMatrix
+	for ii, bvIdx := range currentTableau.BasicVariableIndicies {
+		if factor := T.At(0, bvIdx) / T.At(ii+1, bvIdx); factor != 0 {
+			addScaledRow(T, 0, ii+1, -factor)
+		}
+	}
+
+	// Update the problem
+	err := it.originalProblem.SetObjective(objective.Plus(v.Multiply(delta)), it.originalProblem.Objective.Sense)
+	if err != nil {
+		return fmt.Errorf("SetObjectiveCoefficient: %v", err)
+	}
+	it.replaceTableaus(initialTableau, currentTableau)
+
+	return nil
+}
+
+/*
+SetConstraintRHS
+Description:
+
+	Rewrites the scalar constraint with the given index (in the order given by
+	utils.ExtractScalarConstraints) as
+		a^T x (sense) rhs
+	and updates the right hand side of the current tableau, so that Reoptimize
+	continues from the current basis. If the current basis becomes primal infeasible,
+	then the dual simplex method is used until it is feasible again.
+	Constraints that do not appear in the standard form (e.g., x >= 0) can not be changed.
+	The constraints of the problem are replaced by its scalar constraints and the
+	history of the iterator is restarted from the modified tableau.
+*/
+func (it *TableauAlgorithmIterator) SetConstraintRHS(index int, rhs float64) error {
+	// Input Processing
+	if err := it.checkIsUnscaled("SetConstraintRHS"); err != nil {
+		return err
+	}
+	scalarConstraints := utils.ExtractScalarConstraints(it.originalProblem.Constraints)
+	if index < 0 || index >= len(scalarConstraints) {
+		return fmt.Errorf("SetConstraintRHS: the index %v is outside of the range [0,%v]", index, len(scalarConstraints)-1)
+	}
+
+	rows, signs, err := it.constraintRows()
+	if err != nil {
+		return fmt.Errorf("SetConstraintRHS: %v", err)
+	}
+	if rows[index] == -1 {
+		return fmt.Errorf("SetConstraintRHS: the scalar constraint %v does not appear in the standard form", index)
+	}
+
+	// Rewrite the constraint
+	oldConstraint := scalarConstraints[index]
+	expr, ok := oldConstraint.Left().Minus(oldConstraint.Right()).(symbolic.ScalarExpression)
+	if !ok {
+		return fmt.Errorf("SetConstraintRHS: the constraint %v is not a scalar constraint", oldConstraint)
+	}
+	newConstraint, ok := expr.Minus(expr.Constant()).Comparison(rhs, oldConstraint.ConstrSense()).(symbolic.ScalarConstraint)
+	if !ok {
+		return fmt.Errorf("SetConstraintRHS: could not rewrite the constraint %v", oldConstraint)
+	}
+
+	// Compute the change of the right hand side
+	_, standardFormRHS, err := it.standardFormRowOf(newConstraint, it.initialTableau.Variables)
+	if err != nil {
+		return fmt.Errorf("SetConstraintRHS: %v", err)
+	}
+
+	rowIdx := rows[index] + 1
+	nRows, nCols := it.initialTableau.AsCompressedMatrix.Dims()
+	column := make([]float64, nRows)
+	column[rowIdx] = signs[index]*standardFormRHS - it.initialTableau.AsCompressedMatrix.At(rowIdx, nCols-1)
+
+	currentColumn, err := it.toCurrentTableau(column)
+	if err != nil {
+		return fmt.Errorf("SetConstraintRHS: %v", err)
+	}
+
+	// Update the tableaus
+	initialTableau, currentTableau := *it.initialTableau, *it.State.Tableau
+	initialTableau.AsCompressedMatrix = addToLastColumn(initialTableau.AsCompressedMatrix, column)
+	currentTableau.AsCompressedMatrix = addToLastColumn(currentTableau.AsCompressedMatrix, currentColumn)
+
+	// Update the problem
+	constraints := make([]symbolic.Constraint, len(scalarConstraints))
+	for kk, scalarConstraint := range scalarConstraints {
+		constraints[kk] = scalarConstraint
+	}
+	constraints[index] = newConstraint
+
+	it.originalProblem.Constraints = constraints
+	it.replaceTableaus(initialTableau, currentTableau)
+	it.dualSimplex = true
+
+	return nil
+}
+
+/*
+withRow
+Description:
+
+	Returns the initial and the current tableau with an additional row for the
+	inequality constraint. The row is written as
+		sign * a^T x + s = sign * b
+	with a new slack variable s (sign is -1 for >= constraints) and the basic
+	variables of the current tableau are eliminated from it, so that s is basic.
+*/
+func (it *TableauAlgorithmIterator) withRow(initialTableau, currentTableau utils.Tableau, constraint symbolic.ScalarConstraint) (utils.Tableau, utils.Tableau, error) {
+	// Setup
+	coeffs, rhs, err := it.standardFormRowOf(constraint, initialTableau.Variables)
+	if err != nil {
+		return initialTableau, currentTableau, err
+	}
+
+	sign := 1.0
+	if constraint.ConstrSense() == symbolic.SenseGreaterThanEqual {
+		sign = -1.0
+	}
+
+	// Add the slack variable
+	slack := nextVariable(initialTableau.Variables, " (slack)")
+	variables := append(slices.Clip(initialTableau.Variables), slack)
+	slackIdx := len(variables) - 1
+
+	// Create the row of the initial tableau
+	row := make([]float64, len(variables)+1)
+	for jj := 0; jj < coeffs.Len(); jj++ {
+		row[jj] = sign * coeffs.AtVec(jj)
+	}
+	row[slackIdx] = 1.0
+	row[len(variables)] = sign * rhs
+
+	// Eliminate the basic variables of the current tableau
+	T := insertColumn(currentTableau.AsCompressedMatrix, nil)
+	currentRow := slices.Clone(row)
+	for ii, bvIdx := range currentTableau.BasicVariableIndicies {
+		factor := currentRow[bvIdx] / T.At(ii+1, bvIdx)
+		if factor == 0 {
+			continue
+		}
+		for jj := range currentRow {
+			currentRow[jj] -= factor * T.At(ii+1, jj)
+		}
+	}
+
+	return utils.Tableau{
+		Variables:             variables,
+		BasicVariableIndicies: append(slices.Clone(initialTableau.BasicVariableIndicies), slackIdx),
+		AsCompressedMatrix:    appendRow(insertColumn(initialTableau.AsCompressedMatrix, nil), row),
+	}, utils.Tableau{
+		Variables:             variables,
+		BasicVariableIndicies: append(slices.Clone(currentTableau.BasicVariableIndicies), slackIdx),
+		AsCompressedMatrix:    appendRow(T, currentRow),
+	}, nil
+}
+
+/*
+toCurrentTableau
+Description:
+
+	Transforms a column of the initial tableau (including the objective row) into the
+	corresponding column of the current tableau. The current tableau is M times the
+	initial tableau, where M is read from the slack columns:
+		M = [ e_0 | (T[:, s_r] - T_0[0, s_r] e_0) / T_0[r, s_r] ]
+	This requires a slack variable in every row of the initial tableau.
+*/
+func (it *TableauAlgorithmIterator) toCurrentTableau(column []float64) ([]float64, error) {
+	// Setup
+	initialMatrix := it.initialTableau.AsCompressedMatrix
+	currentMatrix := it.State.Tableau.AsCompressedMatrix
+	nRows, _ := currentMatrix.Dims()
+
+	if len(it.initialTableau.BasicVariableIndicies) != nRows-1 {
+		return nil, fmt.Errorf(
+			"the initial tableau has %v slack variables for %v rows; the tableau can only be modified if every row has a slack",
+			len(it.initialTableau.BasicVariableIndicies),
+			nRows-1,
+		)
+	}
+
+	// Compute M * column
+	out := make([]float64, nRows)
+	out[0] = column[0]
+	for rr, slackIdx := range it.initialTableau.BasicVariableIndicies {
+		if column[rr+1] == 0 {
+			continue
+		}
+		slackCoeff := initialMatrix.At(rr+1, slackIdx)
+		if math.Abs(slackCoeff) <= it.Algorithm.GetPivotTolerance() {
+			return nil, fmt.Errorf("the slack variable of row %v has a zero coefficient", rr)
+		}
+
+		factor := column[rr+1] / slackCoeff
+		out[0] += factor * (currentMatrix.At(0, slackIdx) - initialMatrix.At(0, slackIdx))
+		for ii := 1; ii < nRows; ii++ {
+			out[ii] += factor * currentMatrix.At(ii, slackIdx)
+		}
+	}
+
+	return out, nil
+}
+
+/*
+checkVariablesOf
+Description:
+
+	Returns an error if the constraint contains a variable that is not a variable of the problem.
+*/
+func (it *TableauAlgorithmIterator) checkVariablesOf(constraint symbolic.ScalarConstraint) error {
+	variables := append(constraint.Left().Variables(), constraint.Right().Variables()...)
+	for _, v := range variables {
+		if _, found := it.mapFromOriginalVariablesToStandardFormVariables[v]; !found {
+			return fmt.Errorf("the constraint contains %v, which is not a variable of the problem", v)
+		}
+	}
+	return nil
+}
+
+/*
+checkIsUnscaled
+Description:
+
+	Returns an error if the iterator's problem was scaled (see TableauAlgorithm.Scaling),
+	since the modifications are written in terms of the unscaled standard form.
+*/
+func (it *TableauAlgorithmIterator) checkIsUnscaled(name string) error {
+	if it.scaling != nil {
+		return fmt.Errorf("%v: problems that were scaled cannot be modified", name)
+	}
+	return nil
+}
+
+/*
+senseFactor
+Description:
+
+	Returns 1 if the original problem is a maximization problem and -1 otherwise.
+	The standard form always maximizes, so its objective is senseFactor times the original one.
+*/
+func (it *TableauAlgorithmIterator) senseFactor() float64 {
+	if it.originalProblem.Objective.Sense == problem.SenseMinimize {
+		return -1.0
+	}
+	return 1.0
+}
+
+/*
+replaceTableaus
+Description:
+
+	Makes the modified tableaus the initial and the current tableau of the iterator.
+	The current state keeps its iteration count and the history is restarted from it.
+*/
+func (it *TableauAlgorithmIterator) replaceTableaus(initialTableau, currentTableau utils.Tableau) {
+	state := TableauAlgorithmState{Tableau: &currentTableau, IterationCount: it.State.IterationCount}
+	it.initialTableau, it.unscaledTableau = &initialTableau, &initialTableau
+	it.initialMagnitude = max(it.initialMagnitude, largestMagnitude(initialTableau))
+	it.updateEntryGrowth(currentTableau)
+	it.State = state
+	it.History = []TableauAlgorithmState{state}
+}
+
+// nextVariable creates a nonnegative continuous variable whose ID follows the IDs of the given variables
+// (and of the variables tracked by their environment) and registers it with their environment, so that
+// variables created later in the environment (e.g., with symbolic.NewVariable) get different IDs.
+func nextVariable(variables []symbolic.Variable, nameSuffix string) symbolic.Variable {
+	v := symbolic.Variable{
+		Lower: 0.0,
+		Upper: symbolic.Infinity.Constant(),
+		Type:  symbolic.Continuous,
+	}
+	for ii, other := range variables {
+		if ii == 0 || other.ID >= v.ID {
+			v.ID = other.ID + 1
+		}
+		v.Environment = other.Environment
+	}
+	if v.Environment != nil {
+		v.ID = max(v.ID, uint64(len(v.Environment.AllTrackedVariables())))
+	}
+	v.Name = fmt.Sprintf("x_%v%v", v.ID, nameSuffix)
+
+	if v.Environment != nil {
+		v.Environment.TrackVariable(v)
+	}
+	return v
+}
+
+// insertColumn returns a copy of the tableau matrix with the column (nil for zeros) inserted before its last column.
+func insertColumn(m *mat.Dense, column []float64) *mat.Dense {
+	nRows, nCols := m.Dims()
+	out := mat.NewDense(nRows, nCols+1, nil)
+	for ii := 0; ii < nRows; ii++ {
+		for jj := 0; jj < nCols-1; jj++ {
+			out.Set(ii, jj, m.At(ii, jj))
+		}
+		if column != nil {
+			out.Set(ii, nCols-1, column[ii])
+		}
+		out.Set(ii, nCols, m.At(ii, nCols-1))
+	}
+	return out
+}
+
+// appendRow returns a copy of the matrix with the row appended.
+func appendRow(m *mat.Dense, row []float64) *mat.Dense {
+	nRows, nCols := m.Dims()
+	out := mat.NewDense(nRows+1, nCols, nil)
+	out.Slice(0, nRows, 0, nCols).(*mat.Dense).Copy(m)
+	out.SetRow(nRows, row)
+	return out
+}
+
+// addToObjectiveRow returns a copy of the matrix whose first row is increased by change.
+func addToObjectiveRow(m *mat.Dense, change []float64) *mat.Dense {
+	out := mat.DenseCopyOf(m)
+	for jj, value := range change {
+		out.Set(0, jj, out.At(0, jj)+value)
+	}
+	return out
+}
+
+// addToLastColumn returns a copy of the matrix whose last column is increased by change.
+func addToLastColumn(m *mat.Dense, change []float64) *mat.Dense {
+	out := mat.DenseCopyOf(m)
+	_, nCols := out.Dims()
+	for ii, value := range change {
+		out.Set(ii, nCols-1, out.At(ii, nCols-1)+value)
+	}
+	return out
+}
+
+// addScaledRow adds factor times row src of the matrix to row dst (in place).
+func addScaledRow(m *mat.Dense, dst, src int, factor float64) {
+	_, nCols := m.Dims()
+	for jj := 0; jj < nCols; jj++ {
+		m.Set(dst, jj, m.At(dst, jj)+factor*m.At(src, jj))
+	}
+}
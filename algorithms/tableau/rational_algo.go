@@ -0,0 +1,304 @@
+package tableau_algorithm1
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	tableau_termination "github.com/MatProGo-dev/simplex/algorithms/tableau/termination"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+RationalTableauAlgorithm
+Description:
+
+	The tableau algorithm with exact (big.Rat) arithmetic. The coefficients of the problem
+	are converted into fractions with utils.RatFromFloat64 and every pivot is made on a
+	utils.RationalTableau, so the solution is exact and no tolerances are needed.
+	The pivots are selected like Bland's Rule selects them for the float64 tableau, except
+	that only positive entries of the entering column take part in the ratio test.
+	Like TableauAlgorithm, it starts from the slack basis, so the slack basis of the
+	problem's standard form must be feasible (problems with equality or >= constraints
+	are rejected).
+*/
+type RationalTableauAlgorithm struct {
+	IterationLimit int
+}
+
+/*
+Solve
+Description:
+
+	Solves the problem exactly (see SolveExact) and rounds the solution to float64.
+*/
+func (algo *RationalTableauAlgorithm) Solve(prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
+	sol, err := algo.SolveExact(prob)
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, err
+	}
+	return sol.ToSimplexSolution(), nil
+}
+
+/*
+SolveContext
+Description:
+
+	Same as Solve, but stops pivoting as soon as ctx is done. The solution then
+	describes the last basis that was visited and has the status TIME_LIMIT (if the
+	context's deadline passed) or INTERRUPTED (if the context was cancelled).
+*/
+func (algo *RationalTableauAlgorithm) SolveContext(ctx context.Context, prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
+	sol, err := algo.solveExact(ctx, prob)
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, err
+	}
+	return sol.ToSimplexSolution(), nil
+}
+
+/*
+SolveExact
+Description:
+
+	Solves the problem with exact arithmetic and returns the exact variable values
+	and objective value.
+*/
+func (algo *RationalTableauAlgorithm) SolveExact(prob problem.OptimizationProblem) (simplex_solution.RationalSolution, error) {
+	return algo.solveExact(context.Background(), prob)
+}
+
+/*
+solveExact
+Description:
+
+	Solves the problem like SolveExact, checking ctx before each pivot.
+*/
+func (algo *RationalTableauAlgorithm) solveExact(ctx context.Context, prob problem.OptimizationProblem) (simplex_solution.RationalSolution, error) {
+	// Setup
+	started := time.Now()
+	tableau, varMap, err := algo.initialTableau(&prob)
+	if err != nil {
+		return simplex_solution.RationalSolution{}, err
+	}
+
+	// Pivot until the algorithm terminates
+	var stats simplex_solution.Statistics
+	iterations := 0
+	condition := tableau_termination.DidNotTerminate
+	for condition == tableau_termination.DidNotTerminate {
+		if iterations >= algo.IterationLimit {
+			condition = tableau_termination.MaximumIterationsReached
+			break
+		}
+
+		enteringVarIdx, exitingVarIdx := selectRationalPivot(tableau)
+		switch {
+		case enteringVarIdx == -1:
+			condition = tableau_termination.OptimalSolutionFound
+		case exitingVarIdx == -1:
+			condition = tableau_termination.ProblemIsUnbounded
+		case ctx.Err() == context.DeadlineExceeded:
+			condition = tableau_termination.TimeLimitReached
+		case ctx.Err() == context.Canceled:
+			condition = tableau_termination.Interrupted
+		default:
+			pivotStarted, objectiveBefore := time.Now(), tableau.D()
+			tableau, err = tableau.Pivot(enteringVarIdx, exitingVarIdx)
+			if err != nil {
+				return simplex_solution.RationalSolution{}, fmt.Errorf(
+					"There was an issue pivoting at iteration %v: %v",
+					iterations,
+					err,
+				)
+			}
+			iterations++
+
+			degenerate := 0
+			if tableau.D().Cmp(objectiveBefore) == 0 {
+				degenerate = 1
+			}
+			stats.RecordPivots(simplex_solution.PhasePrimalSimplex, 1, degenerate, time.Since(pivotStarted))
+		}
+	}
+	stats.TotalTime = time.Since(started)
+
+	// Assemble the solution
+	sol := simplex_solution.RationalSolution{
+		Status:          condition.ToOptimizationStatus(),
+		Iterations:      iterations,
+		Statistics:      stats,
+		OriginalProblem: &prob,
+	}
+	if condition == tableau_termination.ProblemIsUnbounded {
+		return sol, nil
+	}
+
+	sol.VariableValues, err = rationalValuesOf(tableau, varMap)
+	if err != nil {
+		return simplex_solution.RationalSolution{}, err
+	}
+	sol.Objective, err = rationalObjectiveOf(&prob, sol.VariableValues)
+	if err != nil {
+		return simplex_solution.RationalSolution{}, err
+	}
+
+	return sol, nil
+}
+
+/*
+initialTableau
+Description:
+
+	Creates the rational tableau of the slack basis of the problem's standard form
+	and checks that the slack basis fits its rows and is feasible
+	(see utils.Tableau.CheckSlackBasis).
+*/
+func (algo *RationalTableauAlgorithm) initialTableau(prob *problem.OptimizationProblem) (utils.RationalTableau, map[symbolic.Variable]symbolic.Expression, error) {
+	// Setup
+	floatTableau, varMap, err := utils.GetInitialTableauFrom(prob)
+	if err != nil {
+		return utils.RationalTableau{}, nil, fmt.Errorf("there was an issue creating the initial tableau: %v", err)
+	}
+
+	// Verify
+	// (without a phase I, the rows of equality and >= constraints have no valid slack)
+	err = floatTableau.CheckSlackBasis()
+	if err != nil {
+		return utils.RationalTableau{}, nil, fmt.Errorf("RationalTableauAlgorithm: %v", err)
+	}
+
+	tableau, err := utils.NewRationalTableau(floatTableau)
+	if err != nil {
+		return utils.RationalTableau{}, nil, err
+	}
+
+	return tableau, varMap, nil
+}
+
+/*
+selectRationalPivot
+Description:
+
+	Selects the entering variable (the non-basic variable with the most negative entry of
+	the objective row, the smallest index on ties) and the exiting variable (the basic
+	variable of the row with the smallest ratio b_i / a_i over the positive entries a_i of
+	the entering column, the smallest variable index on ties).
+	Returns -1 as the entering variable if the tableau is optimal and -1 as the exiting
+	variable if the entering column has no positive entry (i.e., the problem is unbounded).
+*/
+func selectRationalPivot(tableau utils.RationalTableau) (int, int) {
+	// Select the entering variable
+	enteringVarIdx := -1
+	minValue := new(big.Rat)
+	c := tableau.C()
+	for _, nonBasicVarIdx := range tableau.NonBasicVariableIndicies() {
+		if c[nonBasicVarIdx].Cmp(minValue) < 0 {
+			enteringVarIdx = nonBasicVarIdx
+			minValue = c[nonBasicVarIdx]
+		}
+	}
+	if enteringVarIdx == -1 {
+		return -1, -1
+	}
+
+	// Select the exiting variable
+	exitingVarIdx := -1
+	var minRatio *big.Rat
+	b := tableau.B()
+	for ii, bvIdx := range tableau.BasicVariableIndicies {
+		a := tableau.AsCompressedMatrix[ii+1][enteringVarIdx]
+		if a.Sign() <= 0 {
+			continue
+		}
+
+		ratio := new(big.Rat).Quo(b[ii], a)
+		if minRatio == nil || ratio.Cmp(minRatio) < 0 || (ratio.Cmp(minRatio) == 0 && bvIdx < exitingVarIdx) {
+			minRatio = ratio
+			exitingVarIdx = bvIdx
+		}
+	}
+
+	return enteringVarIdx, exitingVarIdx
+}
+
+/*
+rationalValuesOf
+Description:
+
+	Computes the exact value of each original variable (by ID) from the basic solution
+	of the tableau, using the expressions of the original variables in terms of the
+	standard form variables.
+*/
+func rationalValuesOf(tableau utils.RationalTableau, varMap map[symbolic.Variable]symbolic.Expression) (map[uint64]*big.Rat, error) {
+	// Setup
+	standardFormValues := tableau.BasicSolution()
+
+	out := map[uint64]*big.Rat{}
+	for origVar, expr := range varMap {
+		scalarExpr, ok := expr.(symbolic.ScalarExpression)
+		if !ok {
+			return nil, fmt.Errorf("RationalTableauAlgorithm: the expression of variable %v is not a scalar expression", origVar)
+		}
+
+		value, err := evaluateExactly(scalarExpr, tableau.Variables, standardFormValues)
+		if err != nil {
+			return nil, err
+		}
+		out[origVar.ID] = value
+	}
+
+	return out, nil
+}
+
+/*
+rationalObjectiveOf
+Description:
+
+	Evaluates the original (linear) objective of the problem exactly at the given values.
+*/
+func rationalObjectiveOf(prob *problem.OptimizationProblem, values map[uint64]*big.Rat) (*big.Rat, error) {
+	// Setup
+	objective, ok := prob.Objective.Expression.(symbolic.ScalarExpression)
+	if !ok {
+		return nil, fmt.Errorf("RationalTableauAlgorithm: the objective is not a scalar expression")
+	}
+
+	x := make([]*big.Rat, len(prob.Variables))
+	for jj, v := range prob.Variables {
+		x[jj] = values[v.ID]
+		if x[jj] == nil {
+			x[jj] = new(big.Rat)
+		}
+	}
+
+	return evaluateExactly(objective, prob.Variables, x)
+}
+
+/*
+evaluateExactly
+Description:
+
+	Evaluates the linear expression sum_j a_j vars_j + constant at the given values
+	with exact arithmetic (after converting its coefficients with utils.RatFromFloat64).
+*/
+func evaluateExactly(expr symbolic.ScalarExpression, vars []symbolic.Variable, values []*big.Rat) (*big.Rat, error) {
+	// Input Processing
+	if !symbolic.IsLinear(expr) {
+		return nil, fmt.Errorf("RationalTableauAlgorithm: the expression %v is not linear", expr)
+	}
+
+	// Evaluate
+	coeffs := expr.LinearCoeff(vars)
+	out := utils.RatFromFloat64(expr.Constant())
+	for jj := range vars {
+		if coeff := coeffs.AtVec(jj); coeff != 0 {
+			out.Add(out, new(big.Rat).Mul(utils.RatFromFloat64(coeff), values[jj]))
+		}
+	}
+
+	return out, nil
+}
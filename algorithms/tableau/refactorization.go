@@ -0,0 +1,111 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+checkNumericalStability
+Description:
+
+	Recomputes the current tableau from the initial tableau (see refactorize) if the
+	algorithm's RefactorizationFrequency pivots were made since the last refactorization
+	or if the residual of the current basic solution exceeds the algorithm's ResidualTolerance.
+*/
+func (it *TableauAlgorithmIterator) checkNumericalStability() {
+	// Setup
+	it.pivotsSinceRefactorization++
+
+	frequency := it.Algorithm.GetRefactorizationFrequency()
+	if frequency > 0 && it.pivotsSinceRefactorization >= frequency {
+		it.refactorize()
+		return
+	}
+
+	if it.residualOf(*it.State.Tableau) > it.Algorithm.GetResidualTolerance() {
+		it.refactorize()
+	}
+}
+
+/*
+refactorize
+Description:
+
+	Replaces the current tableau by the tableau of the same basis that is recomputed
+	from the initial tableau with a fresh factorization of the basis matrix
+	(see utils.RecomputeTableau). A warning is recorded if the recomputed tableau
+	has a different basic solution or objective value than the current one (i.e.,
+	if the rounding errors of the pivots changed the answer) or if the basis
+	matrix could not be factorized (the current tableau is then kept).
+*/
+func (it *TableauAlgorithmIterator) refactorize() {
+	// Setup
+	it.pivotsSinceRefactorization = 0
+	current := *it.State.Tableau
+
+	recomputed, err := utils.RecomputeTableau(*it.initialTableau, current.BasicVariableIndicies)
+	if err != nil {
+		it.warnings = append(it.warnings, fmt.Sprintf(
+			"the tableau could not be refactorized at iteration %v: %v",
+			it.State.IterationCount,
+			err,
+		))
+		return
+	}
+	it.statistics.Refactorizations++
+
+	// Compare the answers of the two tableaus
+	tolerance := it.Algorithm.GetResidualTolerance()
+	bCurrent, bRecomputed := current.B(), recomputed.B()
+	solutionChange, solutionScale := 0.0, 1.0
+	for ii := 0; ii < bCurrent.Len(); ii++ {
+		solutionChange = max(solutionChange, math.Abs(bRecomputed.AtVec(ii)-bCurrent.AtVec(ii)))
+		solutionScale = max(solutionScale, math.Abs(bCurrent.AtVec(ii)))
+	}
+	objectiveChange := math.Abs(recomputed.D() - current.D())
+
+	if solutionChange > tolerance*solutionScale || objectiveChange > tolerance*(1+math.Abs(current.D())) {
+		it.warnings = append(it.warnings, fmt.Sprintf(
+			"refactorizing the tableau at iteration %v changed the basic solution by %.3g and the objective value by %.3g",
+			it.State.IterationCount,
+			solutionChange,
+			objectiveChange,
+		))
+	}
+
+	// Replace the current tableau (also in the history)
+	it.State.Tableau = &recomputed
+	it.History[len(it.History)-1] = it.State
+	it.updateEntryGrowth(recomputed)
+}
+
+/*
+residualOf
+Description:
+
+	Returns the relative residual max_i |b0 - B x_B|_i / (1 + max_i |b0_i|) of the basic
+	solution x_B of the given tableau in the constraints of the initial tableau [A0 | b0],
+	where B contains the columns of A0 of the basic variables.
+*/
+func (it *TableauAlgorithmIterator) residualOf(tableau utils.Tableau) float64 {
+	// Setup
+	initialMatrix := it.initialTableau.AsCompressedMatrix
+	nRows, nCols := initialMatrix.Dims()
+	_, nTableauCols := tableau.AsCompressedMatrix.Dims()
+
+	// Compute the residual row by row
+	residual, scale := 0.0, 1.0
+	for rr := 1; rr < nRows; rr++ {
+		rowResidual := initialMatrix.At(rr, nCols-1)
+		scale = max(scale, 1+math.Abs(rowResidual))
+		for ii, bvIdx := range tableau.BasicVariableIndicies {
+			rowResidual -= initialMatrix.At(rr, bvIdx) * tableau.AsCompressedMatrix.At(ii+1, nTableauCols-1)
+		}
+		residual = max(residual, math.Abs(rowResidual))
+	}
+
+	return residual / scale
+}
@@ -0,0 +1,262 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/MatProGo-dev/simplex/utils"
+	"gonum.org/v1/gonum/mat"
+)
+
+// geometricMeanPasses is the number of passes over the rows and columns made by geometric mean scaling.
+const geometricMeanPasses = 4
+
+/*
+ScalingMethod
+Description:
+
+	The method used to scale the rows and columns of the standard form before
+	the initial tableau is pivoted.
+	- NoScaling: the standard form is used as it is.
+	- GeometricMeanScaling: each row and then each column is divided by the geometric
+	  mean of its smallest and largest entry (in magnitude), several times over.
+	- EquilibrationScaling: each row and then each column is divided by its largest
+	  entry (in magnitude), so that the largest entry of every row and column is about 1.
+	- GeometricMeanEquilibrationScaling: geometric mean scaling followed by equilibration.
+	All scale factors are rounded to powers of 2, so that scaling does not introduce
+	rounding errors.
+*/
+type ScalingMethod int
+
+const (
+	NoScaling ScalingMethod = iota
+	GeometricMeanScaling
+	EquilibrationScaling
+	GeometricMeanEquilibrationScaling
+)
+
+/*
+String
+Description:
+
+	Returns the name of the scaling method (e.g., as used on the command line).
+*/
+func (sm ScalingMethod) String() string {
+	switch sm {
+	case NoScaling:
+		return "none"
+	case GeometricMeanScaling:
+		return "geometric"
+	case EquilibrationScaling:
+		return "equilibration"
+	case GeometricMeanEquilibrationScaling:
+		return "geometric+equilibration"
+	}
+	return fmt.Sprintf("ScalingMethod(%d)", int(sm))
+}
+
+/*
+ToScalingMethod
+Description:
+
+	Converts the name of a scaling method (see String) back into a ScalingMethod.
+*/
+func ToScalingMethod(name string) (ScalingMethod, error) {
+	for _, sm := range []ScalingMethod{NoScaling, GeometricMeanScaling, EquilibrationScaling, GeometricMeanEquilibrationScaling} {
+		if sm.String() == name {
+			return sm, nil
+		}
+	}
+	return NoScaling, fmt.Errorf("unknown scaling method \"%v\"", name)
+}
+
+/*
+scaling
+Description:
+
+	The scale factors of a standard form: the scaled problem has the constraints
+		(R A S) x~ = R b
+	and the objective (S c)^T x~, where R = diag(rows) and S = diag(columns), so that
+	the original variables are x = S x~ and the original duals are y = R y~.
+	The factor of each slack column is the inverse of its row's factor so that the
+	slacks keep their unit coefficients.
+*/
+type scaling struct {
+	rows    []float64
+	columns []float64
+}
+
+/*
+newScaling
+Description:
+
+	Computes the scale factors of the tableau's standard form with the given method.
+	Returns nil for NoScaling.
+*/
+func newScaling(method ScalingMethod, tableau utils.Tableau) (*scaling, error) {
+	// Input Processing
+	switch method {
+	case NoScaling:
+		return nil, nil
+	case GeometricMeanScaling, EquilibrationScaling, GeometricMeanEquilibrationScaling:
+	default:
+		return nil, fmt.Errorf("newScaling: unknown scaling method %v", method)
+	}
+
+	// Setup
+	A := tableau.A()
+	nRows, nCols := A.Dims()
+	isSlack := map[int]bool{}
+	for _, idx := range tableau.BasicVariableIndicies {
+		isSlack[idx] = true
+	}
+
+	sc := &scaling{rows: make([]float64, nRows), columns: make([]float64, nCols)}
+	for ii := range sc.rows {
+		sc.rows[ii] = 1.0
+	}
+	for jj := range sc.columns {
+		sc.columns[jj] = 1.0
+	}
+
+	// rowExtremes and columnExtremes return the smallest and largest magnitude
+	// of the scaled nonzero entries of a row or column (ignoring the slacks)
+	rowExtremes := func(ii int) (float64, float64) {
+		smallest, largest := math.Inf(1), 0.0
+		for jj := 0; jj < nCols; jj++ {
+			if value := math.Abs(A.At(ii, jj)) * sc.rows[ii] * sc.columns[jj]; value != 0 && !isSlack[jj] {
+				smallest, largest = min(smallest, value), max(largest, value)
+			}
+		}
+		return smallest, largest
+	}
+	columnExtremes := func(jj int) (float64, float64) {
+		smallest, largest := math.Inf(1), 0.0
+		for ii := 0; ii < nRows; ii++ {
+			if value := math.Abs(A.At(ii, jj)) * sc.rows[ii] * sc.columns[jj]; value != 0 {
+				smallest, largest = min(smallest, value), max(largest, value)
+			}
+		}
+		return smallest, largest
+	}
+
+	// Geometric mean passes
+	if method == GeometricMeanScaling || method == GeometricMeanEquilibrationScaling {
+		for pass := 0; pass < geometricMeanPasses; pass++ {
+			for ii := range sc.rows {
+				if smallest, largest := rowExtremes(ii); largest > 0 {
+					sc.rows[ii] /= math.Sqrt(smallest * largest)
+				}
+			}
+			for jj := range sc.columns {
+				if smallest, largest := columnExtremes(jj); largest > 0 && !isSlack[jj] {
+					sc.columns[jj] /= math.Sqrt(smallest * largest)
+				}
+			}
+		}
+	}
+
+	// Equilibration
+	if method == EquilibrationScaling || method == GeometricMeanEquilibrationScaling {
+		for ii := range sc.rows {
+			if _, largest := rowExtremes(ii); largest > 0 {
+				sc.rows[ii] /= largest
+			}
+		}
+		for jj := range sc.columns {
+			if _, largest := columnExtremes(jj); largest > 0 && !isSlack[jj] {
+				sc.columns[jj] /= largest
+			}
+		}
+	}
+
+	// Round to powers of 2 and let the slacks follow their rows
+	for ii := range sc.rows {
+		sc.rows[ii] = math.Exp2(math.Round(math.Log2(sc.rows[ii])))
+	}
+	for jj := range sc.columns {
+		sc.columns[jj] = math.Exp2(math.Round(math.Log2(sc.columns[jj])))
+		if !isSlack[jj] {
+			continue
+		}
+		for ii := 0; ii < nRows; ii++ {
+			if A.At(ii, jj) != 0 {
+				sc.columns[jj] = 1.0 / sc.rows[ii]
+				break
+			}
+		}
+	}
+
+	return sc, nil
+}
+
+/*
+apply
+Description:
+
+	Returns the scaled version of an initial tableau
+		[ -c^T S | -d  ]
+		[ R A S  | R b ]
+*/
+func (sc *scaling) apply(tableau utils.Tableau) utils.Tableau {
+	// Setup
+	T := mat.DenseCopyOf(tableau.AsCompressedMatrix)
+	nRows, nCols := T.Dims()
+
+	for ii := 0; ii < nRows; ii++ {
+		for jj := 0; jj < nCols; jj++ {
+			factor := 1.0
+			if ii > 0 {
+				factor *= sc.rows[ii-1]
+			}
+			if jj < nCols-1 {
+				factor *= sc.columns[jj]
+			}
+			T.Set(ii, jj, T.At(ii, jj)*factor)
+		}
+	}
+
+	return utils.Tableau{
+		Variables:             tableau.Variables,
+		BasicVariableIndicies: slices.Clone(tableau.BasicVariableIndicies),
+		AsCompressedMatrix:    T,
+	}
+}
+
+/*
+unscale
+Description:
+
+	Returns the tableau of the original problem for the same basis as the given tableau
+	of the scaled problem. For a basis B, the tableau of the scaled problem is
+		S_B^(-1) A_B^(-1) [ A S | b ]
+	so each row is multiplied by the scale factor of its basic variable and each column
+	(except the right hand side) by the inverse of its scale factor. The right hand side
+	of the result holds the values of the original (standard form) variables and its
+	objective row their reduced costs.
+*/
+func (sc *scaling) unscale(tableau utils.Tableau) utils.Tableau {
+	// Setup
+	T := mat.DenseCopyOf(tableau.AsCompressedMatrix)
+	nRows, nCols := T.Dims()
+
+	for ii := 0; ii < nRows; ii++ {
+		for jj := 0; jj < nCols; jj++ {
+			factor := 1.0
+			if ii > 0 && ii-1 < len(tableau.BasicVariableIndicies) {
+				factor *= sc.columns[tableau.BasicVariableIndicies[ii-1]]
+			}
+			if jj < nCols-1 {
+				factor /= sc.columns[jj]
+			}
+			T.Set(ii, jj, T.At(ii, jj)*factor)
+		}
+	}
+
+	return utils.Tableau{
+		Variables:             tableau.Variables,
+		BasicVariableIndicies: slices.Clone(tableau.BasicVariableIndicies),
+		AsCompressedMatrix:    T,
+	}
+}
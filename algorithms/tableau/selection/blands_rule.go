@@ -2,13 +2,21 @@ package selection
 
 import (
 	"fmt"
-	"math"
+	"slices"
 
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
 	"github.com/MatProGo-dev/simplex/utils"
 )
 
-type BlandsRule struct{}
+// defaultTolerance is the magnitude below which entries of the entering column are
+// ignored by the ratio test (unless BlandsRule.Tolerance is set).
+const defaultTolerance = 1e-12
+
+type BlandsRule struct {
+	// Tolerance is the magnitude below which entries of the entering column are
+	// ignored by the ratio test. If it is zero, then 1e-12 is used.
+	Tolerance float64
+}
 
 /*
 Description:
@@ -25,15 +33,16 @@ func (br BlandsRule) SelectEnteringVariable(tableau utils.Tableau) int {
 	minIndex := -1
 	minValue := 0.0
 
-	// Get the cost coefficients
-	costCoefficients := tableau.C()
-
 	// Iterate through the non-basic variables and find
 	// the coefficient with the smallest index that is negative
-	for _, nonBasicVarIdx := range tableau.NonBasicVariableIndicies() {
-		if costCoefficients.AtVec(nonBasicVarIdx) < minValue {
-			minIndex = nonBasicVarIdx
-			minValue = costCoefficients.AtVec(nonBasicVarIdx)
+	// (the cost coefficients are read from the objective row of the tableau, without copying them)
+	for jj := range tableau.Variables {
+		if slices.Contains(tableau.BasicVariableIndicies, jj) {
+			continue
+		}
+		if cost := tableau.AsCompressedMatrix.At(0, jj); cost < minValue {
+			minIndex = jj
+			minValue = cost
 		}
 	}
 
@@ -59,22 +68,24 @@ func (br BlandsRule) SelectExitingVariable(tableau utils.Tableau, enteringVarIdx
 	minIndex := -1
 	minRatio := float64(symbolic.Infinity)
 
-	// Get the relevant matrices
-	A := tableau.A()
-	b := tableau.B()
+	// Get the last column of the tableau (the b vector)
+	_, nTableauCols := tableau.AsCompressedMatrix.Dims()
+	bCol := nTableauCols - 1
 
-	// Create the vector of ratios
-	ratios := make([]float64, tableau.NumberOfConstraints())
-	for i := 0; i < tableau.NumberOfConstraints(); i++ {
-		if math.Abs(A.At(i, enteringVarIdx)) > 1e-12 {
-			ratios[i] = b.AtVec(i) / A.At(i, enteringVarIdx)
-		} else {
-			ratios[i] = -1.0 // Indicate that this variable cannot be used
-		}
+	tolerance := br.Tolerance
+	if tolerance <= 0 {
+		tolerance = defaultTolerance
 	}
 
-	// Iterate through the ratios and find the smallest one
-	for i, ratio := range ratios {
+	// Find the row with the smallest ratio
+	// (only rows with a positive entry limit the entering variable; a negative entry
+	// with b = 0 would give the ratio -0, which passes the ratio >= 0 test below)
+	for i := 0; i < tableau.NumberOfConstraints(); i++ {
+		a := tableau.AsCompressedMatrix.At(i+1, enteringVarIdx)
+		if a <= tolerance {
+			continue // This row cannot be used
+		}
+		ratio := tableau.AsCompressedMatrix.At(i+1, bCol) / a
 		if ratio >= 0 { // Only consider valid ratios
 			if ratio < minRatio || (ratio == minRatio && tableau.BasicVariableIndicies[i] < tableau.BasicVariableIndicies[minIndex]) {
 				minRatio = ratio
@@ -100,8 +111,6 @@ func (br BlandsRule) SelectEnteringAndExitingVariables(tableau utils.Tableau) (i
 		return -1, -1, nil // Optimal solution found, no entering variable
 	}
 
-	fmt.Println("Entering variable index:", enteringVarIdx)
-
 	// Select the exiting variable
 	exitingVarIdx := br.SelectExitingVariable(tableau, enteringVarIdx)
 	if exitingVarIdx == -1 {
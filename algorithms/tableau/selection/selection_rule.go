@@ -0,0 +1,17 @@
+package selection
+
+import "github.com/MatProGo-dev/simplex/utils"
+
+/*
+SelectionRule
+Description:
+
+	A rule that picks the entering and exiting variables of a pivot.
+	All indices refer to positions in `tableau.Variables`; -1 means that
+	no variable could be selected.
+*/
+type SelectionRule interface {
+	SelectEnteringVariable(tableau utils.Tableau) int
+	SelectExitingVariable(tableau utils.Tableau, enteringVarIdx int) int
+	SelectEnteringAndExitingVariables(tableau utils.Tableau) (int, int, error)
+}
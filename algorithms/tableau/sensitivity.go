@@ -0,0 +1,224 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/utils"
+	"gonum.org/v1/gonum/mat"
+)
+
+// rowMatchTolerance is the relative tolerance used to match the rows of the standard form
+// to the constraints of the original problem.
+const rowMatchTolerance = 1e-9
+
+/*
+DualValues
+Description:
+
+	Computes the dual value of each scalar constraint of the original problem
+	(in the order given by utils.ExtractScalarConstraints) for the current basis.
+	The dual value of a constraint is the rate of change of the objective of the
+	original problem with respect to the constraint's right hand side.
+
+	The duals of the standard form rows are computed from the initial tableau as
+		y = A_B^(-T) * c_B
+	(and multiplied by the row scale factors if the problem was scaled)
+	and each row is mapped back to the original constraint it was created from.
+	Constraints that do not appear in the standard form (e.g., nonnegativity
+	constraints and redundant constraints) have a dual value of zero; their
+	effect is contained in the reduced costs.
+*/
+func (it *TableauAlgorithmIterator) DualValues() ([]float64, error) {
+	// Setup
+	initialTableau := it.initialTableau
+	finalTableau := it.State.Tableau
+	A0 := initialTableau.A()
+	nRows, _ := A0.Dims()
+
+	// Compute the duals of the standard form (which maximizes -C()^T x)
+	ABasic := mat.NewDense(nRows, nRows, nil)
+	cBasic := mat.NewVecDense(nRows, nil)
+	c0 := initialTableau.C()
+	for ii, bvIdx := range finalTableau.BasicVariableIndicies {
+		ABasic.SetCol(ii, mat.Col(nil, bvIdx, A0))
+		cBasic.SetVec(ii, -c0.AtVec(bvIdx))
+	}
+
+	var yStandard mat.VecDense
+	err := yStandard.SolveVec(ABasic.T(), cBasic)
+	if err != nil {
+		return nil, fmt.Errorf("DualValues: the basis matrix is singular (%v)", err)
+	}
+	if it.scaling != nil {
+		for ii := 0; ii < yStandard.Len(); ii++ {
+			yStandard.SetVec(ii, yStandard.AtVec(ii)*it.scaling.rows[ii])
+		}
+	}
+
+	// The standard form maximizes, so the duals flip sign for minimization problems
+	senseFactor := 1.0
+	if it.originalProblem.Objective.Sense == problem.SenseMinimize {
+		senseFactor = -1.0
+	}
+
+	// Map each row of the standard form to an original constraint
+	rows, signs, err := it.constraintRows()
+	if err != nil {
+		return nil, err
+	}
+
+	duals := make([]float64, len(rows))
+	for kk, ii := range rows {
+		if ii == -1 {
+			continue
+		}
+		if dual := signs[kk] * yStandard.AtVec(ii) * senseFactor; dual != 0 {
+			duals[kk] = dual // Avoids storing -0
+		}
+	}
+
+	return duals, nil
+}
+
+/*
+constraintRows
+Description:
+
+	Finds the row of the standard form that each scalar constraint of the original
+	problem (in the order given by utils.ExtractScalarConstraints) was turned into.
+	Returns the row index (or -1 if the constraint does not appear in the standard form)
+	and the sign (+1 or -1) with which the constraint appears in that row.
+*/
+func (it *TableauAlgorithmIterator) constraintRows() ([]int, []float64, error) {
+	// Setup
+	initialTableau := it.unscaledTableau
+	A0, b0 := initialTableau.A(), initialTableau.B()
+	nRows, _ := A0.Dims()
+
+	constraints := utils.ExtractScalarConstraints(it.originalProblem.Constraints)
+	rows := make([]int, len(constraints))
+	signs := make([]float64, len(constraints))
+	isSlack := map[int]bool{}
+	for _, idx := range initialTableau.BasicVariableIndicies {
+		isSlack[idx] = true
+	}
+
+	for kk, constraint := range constraints {
+		// Write the constraint in terms of the standard form variables
+		coeffs, rhs, err := it.standardFormRowOf(constraint, initialTableau.Variables)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rows[kk] = -1
+		for ii := 0; ii < nRows && rows[kk] == -1; ii++ {
+			for _, sign := range []float64{1.0, -1.0} {
+				if rowsMatch(A0, b0, ii, coeffs, rhs, sign, isSlack) {
+					rows[kk], signs[kk] = ii, sign
+					break
+				}
+			}
+		}
+	}
+
+	return rows, signs, nil
+}
+
+/*
+ReducedCosts
+Description:
+
+	Computes the reduced cost
+		c_j - sum_k y_k * a_kj
+	of each variable x_j of the original problem (by ID), where c is the objective,
+	a_k are the coefficients of the k-th scalar constraint and y are the dual values
+	computed by DualValues.
+*/
+func (it *TableauAlgorithmIterator) ReducedCosts(duals []float64) (map[uint64]float64, error) {
+	// Setup
+	originalProblem := it.originalProblem
+	objective, ok := originalProblem.Objective.Expression.(symbolic.ScalarExpression)
+	if !ok {
+		return nil, fmt.Errorf("ReducedCosts: the objective is not a scalar expression")
+	}
+	c := objective.LinearCoeff(originalProblem.Variables)
+
+	constraints := utils.ExtractScalarConstraints(originalProblem.Constraints)
+	if len(constraints) != len(duals) {
+		return nil, fmt.Errorf(
+			"ReducedCosts: expected %v dual values, but received %v",
+			len(constraints),
+			len(duals),
+		)
+	}
+
+	// Subtract the contribution of each constraint
+	reducedCosts := mat.NewVecDense(len(originalProblem.Variables), nil)
+	reducedCosts.CopyVec(&c)
+	for kk, constraint := range constraints {
+		if duals[kk] == 0 {
+			continue
+		}
+		expr := constraint.Left().Minus(constraint.Right()).(symbolic.ScalarExpression)
+		a := expr.LinearCoeff(originalProblem.Variables)
+		reducedCosts.AddScaledVec(reducedCosts, -duals[kk], &a)
+	}
+
+	out := map[uint64]float64{}
+	for jj, v := range originalProblem.Variables {
+		out[v.ID] = reducedCosts.AtVec(jj)
+	}
+	return out, nil
+}
+
+/*
+standardFormRowOf
+Description:
+
+	Substitutes the standard form variables into the constraint and returns the
+	coefficients (with respect to standardFormVariables) and the right hand side of
+	the resulting linear constraint.
+*/
+func (it *TableauAlgorithmIterator) standardFormRowOf(
+	constraint symbolic.ScalarConstraint,
+	standardFormVariables []symbolic.Variable,
+) (mat.VecDense, float64, error) {
+	expr := constraint.Left().Minus(constraint.Right())
+	substituted, ok := expr.SubstituteAccordingTo(it.mapFromOriginalVariablesToStandardFormVariables).(symbolic.ScalarExpression)
+	if !ok {
+		return mat.VecDense{}, 0.0, fmt.Errorf("the constraint %v is not a scalar constraint", constraint)
+	}
+
+	return substituted.LinearCoeff(standardFormVariables), -substituted.Constant(), nil
+}
+
+/*
+rowsMatch
+Description:
+
+	Returns true if row ii of [A | b] (ignoring the slack columns) is equal to
+	sign * [coeffs | rhs].
+*/
+func rowsMatch(A *mat.Dense, b *mat.VecDense, ii int, coeffs mat.VecDense, rhs float64, sign float64, isSlack map[int]bool) bool {
+	closeTo := func(x, y float64) bool {
+		return math.Abs(x-y) <= rowMatchTolerance*(1+math.Max(math.Abs(x), math.Abs(y)))
+	}
+
+	if !closeTo(b.AtVec(ii), sign*rhs) {
+		return false
+	}
+
+	_, nCols := A.Dims()
+	for jj := 0; jj < nCols; jj++ {
+		if isSlack[jj] {
+			continue
+		}
+		if !closeTo(A.At(ii, jj), sign*coeffs.AtVec(jj)) {
+			return false
+		}
+	}
+	return true
+}
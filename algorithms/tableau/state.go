@@ -59,8 +59,10 @@ Description:
 */
 func (state *TableauAlgorithmState) Check() error {
 	// Check that the count is a non-negative number
+	// (the error keeps a copy of the state, so that a valid state does not escape to the heap)
 	if state.IterationCount < 0 {
-		return algorithms.MakeIterationCountIsNegativeError(state)
+		invalidState := *state
+		return algorithms.MakeIterationCountIsNegativeError(&invalidState)
 	}
 
 	// Check that the number of columns is equal to len(AllVariables) + 1
@@ -234,7 +236,25 @@ func (state *TableauAlgorithmState) GetReducedCostVector() (*mat.VecDense, error
 	return &finalReducedCost, nil
 }
 
+/*
+CalculateNextState
+Description:
+
+	Performs one pivot on the current state, using Bland's Rule to select
+	the entering and exiting variables.
+*/
 func (state *TableauAlgorithmState) CalculateNextState() (TableauAlgorithmState, error) {
+	return state.CalculateNextStateUsing(selection.BlandsRule{})
+}
+
+/*
+CalculateNextStateUsing
+Description:
+
+	Performs one pivot on the current state, using the given selection rule to select
+	the entering and exiting variables.
+*/
+func (state *TableauAlgorithmState) CalculateNextStateUsing(selectionRule selection.SelectionRule) (TableauAlgorithmState, error) {
 	// Input Checking
 	err := state.Check()
 	if err != nil {
@@ -244,9 +264,6 @@ func (state *TableauAlgorithmState) CalculateNextState() (TableauAlgorithmState,
 	// fmt.Println("Calculating next state from tableau:", mat.Formatted(state.Tableau.AsCompressedMatrix))
 
 	// Select the pivot column and row (i.e., the entering and exiting variables in the tableau)
-	// Here, we use Bland's Rule to select the entering variable
-	// TODO(Kwesi): Make other rules available
-	selectionRule := selection.BlandsRule{}
 	enteringVarIdx, exitingVarIdx, err := selectionRule.SelectEnteringAndExitingVariables(*state.Tableau)
 	if err != nil {
 		return TableauAlgorithmState{}, VariableSelectionError{EnteringVarIndex: enteringVarIdx, ExitingVarIndex: exitingVarIdx}
@@ -376,6 +393,16 @@ func (state *TableauAlgorithmState) ToSolution(
 			)
 	}
 
+	// Construct the objective value and the constraint activities
+	err = sol.EvaluateAtVariableValues()
+	if err != nil {
+		return sol,
+			fmt.Errorf(
+				"There was an issue evaluating the objective and constraints at termination: %v",
+				err,
+			)
+	}
+
 	// Assemble Solution Output
 	return sol, nil
 }
@@ -0,0 +1,93 @@
+package tableau_algorithm1
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+recordPivot
+Description:
+
+	Adds a pivot of the given phase from a tableau with the objective value objectiveBefore
+	to the tableau after (which started at the given time) to the iterator's statistics.
+	The pivot is degenerate if it did not change the objective value.
+*/
+func (it *TableauAlgorithmIterator) recordPivot(phase string, objectiveBefore float64, after utils.Tableau, started time.Time) {
+	// Setup
+	objectiveAfter := after.D()
+	tolerance := it.Algorithm.GetPivotTolerance() * (1 + math.Abs(objectiveBefore))
+
+	degenerate := 0
+	if math.Abs(objectiveAfter-objectiveBefore) <= tolerance {
+		degenerate = 1
+	}
+
+	it.statistics.RecordPivots(phase, 1, degenerate, time.Since(started))
+	it.updateEntryGrowth(after)
+}
+
+/*
+updateEntryGrowth
+Description:
+
+	Records the largest magnitude of an entry of the given tableau.
+*/
+func (it *TableauAlgorithmIterator) updateEntryGrowth(tableau utils.Tableau) {
+	it.maxMagnitude = max(it.maxMagnitude, largestMagnitude(tableau))
+}
+
+/*
+statisticsAtFinish
+Description:
+
+	Returns a copy of the iterator's statistics completed with the total time since
+	the iterator was created, the entry growth and the condition number estimate of
+	the current basis.
+*/
+func (it *TableauAlgorithmIterator) statisticsAtFinish() simplex_solution.Statistics {
+	// Setup
+	out := it.statistics
+	out.Phases = slices.Clone(it.statistics.Phases)
+	out.TotalTime = time.Since(it.started)
+
+	// Entry growth
+	if it.initialMagnitude > 0 {
+		out.MaxEntryGrowth = max(it.maxMagnitude, it.initialMagnitude) / it.initialMagnitude
+	}
+
+	// Condition number of the basis matrix (of the unscaled problem)
+	A0 := it.unscaledTableau.A()
+	nRows, _ := A0.Dims()
+	if nRows > 0 {
+		ABasic := mat.NewDense(nRows, nRows, nil)
+		for ii, bvIdx := range it.State.Tableau.BasicVariableIndicies {
+			ABasic.SetCol(ii, mat.Col(nil, bvIdx, A0))
+		}
+		out.BasisConditionEstimate = mat.Cond(ABasic, 1)
+	}
+
+	return out
+}
+
+/*
+largestMagnitude
+Description:
+
+	Returns the largest magnitude of an entry of the tableau's compressed matrix.
+*/
+func largestMagnitude(tableau utils.Tableau) float64 {
+	out := 0.0
+	nRows, nCols := tableau.AsCompressedMatrix.Dims()
+	for ii := 0; ii < nRows; ii++ {
+		for jj := 0; jj < nCols; jj++ {
+			out = max(out, math.Abs(tableau.AsCompressedMatrix.At(ii, jj)))
+		}
+	}
+	return out
+}
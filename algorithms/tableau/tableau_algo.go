@@ -1,17 +1,117 @@
 package tableau_algorithm1
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/simplex/algorithms/tableau/selection"
 	tableau_termination "github.com/MatProGo-dev/simplex/algorithms/tableau/termination"
 	simplex_solution "github.com/MatProGo-dev/simplex/solution"
-	"github.com/MatProGo-dev/simplex/utils"
-	"gonum.org/v1/gonum/mat"
 )
 
+// defaultPivotTolerance is the magnitude below which tableau entries are treated as zero
+// when pivots are selected or validated (unless TableauAlgorithm.PivotTolerance is set).
+const defaultPivotTolerance = 1e-12
+
+// defaultRefactorizationFrequency is the number of pivots after which the tableau is
+// recomputed from the initial tableau (unless TableauAlgorithm.RefactorizationFrequency is set).
+const defaultRefactorizationFrequency = 100
+
+// defaultResidualTolerance is the relative residual of the basic solution above which the
+// tableau is recomputed early (unless TableauAlgorithm.ResidualTolerance is set).
+const defaultResidualTolerance = 1e-9
+
 type TableauAlgorithm struct {
 	IterationLimit int
+	// SelectionRule picks the entering and exiting variables of each pivot.
+	// If it is nil, then Bland's Rule is used.
+	SelectionRule selection.SelectionRule
+	// PivotTolerance is the magnitude below which tableau entries are treated as zero
+	// when pivots are selected or validated. If it is zero, then 1e-12 is used.
+	PivotTolerance float64
+	// OptimalityTolerance is the amount by which a reduced cost may be negative
+	// while the tableau is still considered optimal.
+	OptimalityTolerance float64
+	// InitialBasis is an optional basis (e.g., the Basis of an earlier, similar solve)
+	// from which the algorithm starts instead of the slack basis. Parts of the basis
+	// that are singular or infeasible for the problem are replaced by slacks.
+	InitialBasis *simplex_solution.Basis
+	// Scaling is the method used to scale the rows and columns of the standard form
+	// before pivoting (no scaling by default). The solution is always given in terms
+	// of the original problem.
+	Scaling ScalingMethod
+	// RefactorizationFrequency is the number of pivots after which the current tableau is
+	// recomputed from the initial tableau and the current basis, which removes the rounding
+	// error accumulated by the pivots. If it is zero, then 100 is used; if it is negative,
+	// then the tableau is only recomputed when the residual check fails.
+	RefactorizationFrequency int
+	// ResidualTolerance is the relative residual max_i |b0 - B x_B|_i / (1 + max_i |b0_i|)
+	// of the current basic solution in the initial constraints above which the tableau is
+	// recomputed before the next periodic refactorization. If it is zero, then 1e-9 is used.
+	ResidualTolerance float64
+	// InPlacePivoting makes the iterator pivot one working copy of the tableau in place
+	// instead of creating a new tableau for every pivot. With the default selection rule,
+	// such a pivot allocates no memory, except when the tableau is refactorized (see
+	// RefactorizationFrequency). The states returned by the iterator share their tableau
+	// and its History only contains the current state, so it cannot be used for tracing
+	// (e.g., HistoryToLaTeX).
+	InPlacePivoting bool
+}
+
+/*
+GetSelectionRule
+Description:
+
+	Returns the selection rule used by the algorithm (Bland's Rule by default).
+*/
+func (algo *TableauAlgorithm) GetSelectionRule() selection.SelectionRule {
+	if algo.SelectionRule == nil {
+		return selection.BlandsRule{Tolerance: algo.GetPivotTolerance()}
+	}
+	return algo.SelectionRule
+}
+
+/*
+GetPivotTolerance
+Description:
+
+	Returns the pivot tolerance used by the algorithm (1e-12 by default).
+*/
+func (algo *TableauAlgorithm) GetPivotTolerance() float64 {
+	if algo.PivotTolerance <= 0 {
+		return defaultPivotTolerance
+	}
+	return algo.PivotTolerance
+}
+
+/*
+GetRefactorizationFrequency
+Description:
+
+	Returns the number of pivots between two refactorizations (100 by default,
+	0 if periodic refactorization is disabled).
+*/
+func (algo *TableauAlgorithm) GetRefactorizationFrequency() int {
+	switch {
+	case algo.RefactorizationFrequency == 0:
+		return defaultRefactorizationFrequency
+	case algo.RefactorizationFrequency < 0:
+		return 0
+	}
+	return algo.RefactorizationFrequency
+}
+
+/*
+GetResidualTolerance
+Description:
+
+	Returns the residual tolerance used by the algorithm (1e-9 by default).
+*/
+func (algo *TableauAlgorithm) GetResidualTolerance() float64 {
+	if algo.ResidualTolerance <= 0 {
+		return defaultResidualTolerance
+	}
+	return algo.ResidualTolerance
 }
 
 func (algo *TableauAlgorithm) CheckTerminationConditions(state TableauAlgorithmState) (tableau_termination.TerminationType, error) {
@@ -27,69 +127,82 @@ func (algo *TableauAlgorithm) CheckTerminationConditions(state TableauAlgorithmS
 	}
 
 	// Check that the reduced costs are all non-negative
-	if state.Tableau.CanNotBeImproved() {
+	if state.Tableau.CanNotBeImprovedWithin(algo.OptimalityTolerance) {
 		return tableau_termination.OptimalSolutionFound, nil
 	}
 
+	// Check whether an improving variable can be increased without bound
+	// (like the other algorithms, this is reported as a status and not as an error)
+	if state.Tableau.IsUnboundedWithin(algo.OptimalityTolerance, algo.GetPivotTolerance()) {
+		return tableau_termination.ProblemIsUnbounded, nil
+	}
+
 	return tableau_termination.DidNotTerminate, nil
 }
 
 func (algo *TableauAlgorithm) Solve(prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
-	// Setup
+	sol, _, err := algo.SolveWithHistory(prob)
+	return sol, err
+}
+
+/*
+SolveContext
+Description:
 
-	// Create initial Tableau state from the problem
-	initialTableau, mapFromOriginalVariablesToStandardFormVariables, err := utils.GetInitialTableauFrom(&prob)
+	Same as Solve, but stops pivoting as soon as ctx is done. The solution then
+	describes the last basis that was visited and has the status TIME_LIMIT (if the
+	context's deadline passed) or INTERRUPTED (if the context was cancelled).
+*/
+func (algo *TableauAlgorithm) SolveContext(ctx context.Context, prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
+	// Setup
+	iterator, err := algo.NewIterator(prob)
 	if err != nil {
-		return simplex_solution.SimplexSolution{}, fmt.Errorf("there was an issue creating the initial tableau: %v", err)
-	}
-	stateII := TableauAlgorithmState{
-		Tableau:        &initialTableau,
-		IterationCount: 0,
+		return simplex_solution.SimplexSolution{}, err
 	}
 
 	// Loop
-	sol := simplex_solution.SimplexSolution{}
-	for iter := 0; iter < algo.IterationLimit; iter++ {
-		// Test for Termination
-		condition, err := algo.CheckTerminationConditions(stateII)
-		if err != nil {
-			return simplex_solution.SimplexSolution{},
-				fmt.Errorf(
-					"There was an issue checking the termination condition at iteration %v: %v",
-					iter,
-					err,
-				)
+	for !iterator.Terminated() {
+		// Check the context before each pivot
+		switch ctx.Err() {
+		case context.DeadlineExceeded:
+			return iterator.FinishWith(tableau_termination.TimeLimitReached)
+		case context.Canceled:
+			return iterator.FinishWith(tableau_termination.Interrupted)
 		}
 
-		if condition != tableau_termination.DidNotTerminate {
-			sol, err = stateII.ToSolution(condition, mapFromOriginalVariablesToStandardFormVariables, &prob)
-			if err != nil {
-				return simplex_solution.SimplexSolution{},
-					fmt.Errorf(
-						"There was an issue converting the final state to a solution at iteration %v: %v",
-						iter,
-						err,
-					)
-			}
-			// Exit the loop
-			break
+		_, err = iterator.Next()
+		if err != nil {
+			return simplex_solution.SimplexSolution{}, err
 		}
+	}
+
+	return iterator.Finish()
+}
 
-		fmt.Println("Iteration: ", iter)
-		fmt.Println("Matrix: ", mat.Formatted(stateII.Tableau.AsCompressedMatrix))
+/*
+SolveWithHistory
+Description:
 
+	Solves the provided optimization problem and also returns every state that the
+	algorithm visited (starting with the initial tableau). The history can be rendered
+	with HistoryToLaTeX.
+*/
+func (algo *TableauAlgorithm) SolveWithHistory(prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, []TableauAlgorithmState, error) {
+	// Setup
+	iterator, err := algo.NewIterator(prob)
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, nil, err
+	}
+
+	// Loop
+	for !iterator.Terminated() {
 		// Update the state
-		stateII, err = stateII.CalculateNextState()
+		_, err = iterator.Next()
 		if err != nil {
-			return simplex_solution.SimplexSolution{},
-				fmt.Errorf(
-					"There was an issue updating the state at iteration %v: %v",
-					iter,
-					err,
-				)
+			return simplex_solution.SimplexSolution{}, iterator.History, err
 		}
-
 	}
 
-	return sol, nil
+	sol, err := iterator.Finish()
+	return sol, iterator.History, err
 }
@@ -9,6 +9,10 @@ type TerminationType string
 const DidNotTerminate TerminationType = "Did Not Terminate"
 const MaximumIterationsReached TerminationType = "Maximum Iterations Reached"
 const OptimalSolutionFound TerminationType = "Optimal Solution Found"
+const TimeLimitReached TerminationType = "Time Limit Reached"
+const Interrupted TerminationType = "Interrupted"
+const ProblemIsInfeasible TerminationType = "Problem Is Infeasible"
+const ProblemIsUnbounded TerminationType = "Problem Is Unbounded"
 
 func (tt TerminationType) ToOptimizationStatus() solution_status.SolutionStatus {
 	switch tt {
@@ -18,6 +22,14 @@ func (tt TerminationType) ToOptimizationStatus() solution_status.SolutionStatus
 		return solution_status.ITERATION_LIMIT
 	case OptimalSolutionFound:
 		return solution_status.OPTIMAL
+	case TimeLimitReached:
+		return solution_status.TIME_LIMIT
+	case Interrupted:
+		return solution_status.INTERRUPTED
+	case ProblemIsInfeasible:
+		return solution_status.INFEASIBLE
+	case ProblemIsUnbounded:
+		return solution_status.UNBOUNDED
 	default:
 		return solution_status.INPROGRESS
 	}
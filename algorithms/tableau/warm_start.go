@@ -0,0 +1,235 @@
+package tableau_algorithm1
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+warmStart
+Description:
+
+	Replaces the slack basis of the iterator's initial state by the given basis.
+	The columns that the basis marks as basic (the columns of the basic original
+	variables and the slacks of the constraints whose status is basic) are pivoted
+	into the initial tableau; the rows that they do not fill keep their slacks.
+	Columns that would make the basis singular are skipped.
+
+	If the resulting basis is primal infeasible, then it is repaired: starting again
+	from the slack basis, each requested column is only pivoted in if the basis stays
+	feasible, so that the slacks take the place of the columns that do not fit.
+	Variables and constraints that the problem does not have are ignored.
+*/
+func (it *TableauAlgorithmIterator) warmStart(basis *simplex_solution.Basis) error {
+	// Collect the requested basic columns
+	desired, err := it.basicColumnsOf(basis)
+	if err != nil {
+		return fmt.Errorf("warmStart: %v", err)
+	}
+
+	isDesired := map[int]bool{}
+	for _, colIdx := range desired {
+		isDesired[colIdx] = true
+	}
+
+	// Pivot the columns in
+	started := time.Now()
+	tableau, pivots := it.pivotIn(*it.initialTableau, desired, isDesired, false)
+	if !it.isPrimalFeasible(tableau) {
+		var repairPivots int
+		tableau, repairPivots = it.pivotIn(*it.initialTableau, desired, isDesired, true)
+		pivots += repairPivots
+	}
+	it.statistics.RecordPivots(simplex_solution.PhaseWarmStart, pivots, 0, time.Since(started))
+	it.updateEntryGrowth(tableau)
+
+	// Replace the initial state
+	state0 := TableauAlgorithmState{Tableau: &tableau, IterationCount: 0}
+	it.State = state0
+	it.History = []TableauAlgorithmState{state0}
+
+	return nil
+}
+
+/*
+pivotIn
+Description:
+
+	Makes the given columns basic one at a time, each replacing a basic variable that
+	is not one of the requested columns. The rows are tried in the order of decreasing
+	magnitude of the pivot element. If keepFeasible is true, then only pivots that
+	keep the right hand side nonnegative are used. Columns that cannot be pivoted in
+	are skipped. Returns the resulting tableau and the number of pivots that were made.
+*/
+func (it *TableauAlgorithmIterator) pivotIn(tableau utils.Tableau, columns []int, isDesired map[int]bool, keepFeasible bool) (utils.Tableau, int) {
+	// Setup
+	tolerance := it.Algorithm.GetPivotTolerance()
+	pivots := 0
+
+	for _, colIdx := range columns {
+		if foundIdx, _ := symbolic.FindInSlice(colIdx, tableau.BasicVariableIndicies); foundIdx != -1 {
+			continue
+		}
+
+		// Order the candidate rows
+		A := tableau.A()
+		var candidates []int
+		for ii, bvIdx := range tableau.BasicVariableIndicies {
+			if !isDesired[bvIdx] && math.Abs(A.At(ii, colIdx)) > tolerance {
+				candidates = append(candidates, ii)
+			}
+		}
+		sort.SliceStable(candidates, func(ii, jj int) bool {
+			return math.Abs(A.At(candidates[ii], colIdx)) > math.Abs(A.At(candidates[jj], colIdx))
+		})
+
+		// Use the first acceptable pivot
+		for _, rowIdx := range candidates {
+			next, err := tableau.Pivot(colIdx, tableau.BasicVariableIndicies[rowIdx])
+			if err != nil || (keepFeasible && !it.isPrimalFeasible(next)) {
+				continue
+			}
+			tableau = next
+			pivots++
+			break
+		}
+	}
+
+	return tableau, pivots
+}
+
+/*
+isPrimalFeasible
+Description:
+
+	Returns true if the right hand side of the tableau is nonnegative
+	(up to the algorithm's pivot tolerance).
+*/
+func (it *TableauAlgorithmIterator) isPrimalFeasible(tableau utils.Tableau) bool {
+	tolerance := it.Algorithm.GetPivotTolerance()
+	nRows, nCols := tableau.AsCompressedMatrix.Dims()
+	for ii := 1; ii < nRows; ii++ {
+		if tableau.AsCompressedMatrix.At(ii, nCols-1) < -tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+basicColumnsOf
+Description:
+
+	Translates the basic variables and the basic constraint slacks of the basis into
+	columns of the standard form. A basic original variable that was split into a
+	positive and a negative part is represented by its positive part.
+*/
+func (it *TableauAlgorithmIterator) basicColumnsOf(basis *simplex_solution.Basis) ([]int, error) {
+	// Setup
+	var out []int
+
+	// Variables (in the order of the problem, so that the result is deterministic)
+	for _, v := range it.originalProblem.Variables {
+		if status, found := basis.VariableStatus[v.ID]; !found || status != simplex_solution.BasisStatusBasic {
+			continue
+		}
+
+		positiveIdx, negativeIdx, err := it.standardFormColumnsOf(v)
+		if err != nil {
+			return nil, err
+		}
+		if positiveIdx != -1 {
+			out = append(out, positiveIdx)
+		} else if negativeIdx != -1 {
+			out = append(out, negativeIdx)
+		}
+	}
+
+	// Constraint slacks (constraints without a slack, e.g., equality constraints, are skipped)
+	rows, _, err := it.constraintRows()
+	if err != nil {
+		return nil, err
+	}
+	slacks := it.slackColumns()
+	for kk, status := range basis.ConstraintStatus {
+		if kk >= len(rows) || rows[kk] == -1 || slacks[rows[kk]] == -1 || status != simplex_solution.BasisStatusBasic {
+			continue
+		}
+		out = append(out, slacks[rows[kk]])
+	}
+
+	return out, nil
+}
+
+/*
+slackColumns
+Description:
+
+	Returns the column of the slack of each row of the initial tableau, or -1 for the
+	rows without a slack (e.g., the rows of equality constraints). The slacks are the
+	basic variables of the initial tableau, each of which is nonzero in its own row only.
+	(The rows cannot be matched to BasicVariableIndicies by position, since a problem
+	with equality constraints has fewer slacks than rows.)
+*/
+func (it *TableauAlgorithmIterator) slackColumns() []int {
+	// Setup
+	A0 := it.initialTableau.A()
+	nRows, _ := A0.Dims()
+	out := make([]int, nRows)
+	for ii := range out {
+		out[ii] = -1
+	}
+
+	// Find the row of each slack
+	for _, colIdx := range it.initialTableau.BasicVariableIndicies {
+		for ii := 0; ii < nRows; ii++ {
+			if A0.At(ii, colIdx) != 0 {
+				out[ii] = colIdx
+				break
+			}
+		}
+	}
+
+	return out
+}
+
+/*
+standardFormColumnsOf
+Description:
+
+	Returns the columns of the standard form that contain the positive part and
+	the negative part of the original variable v (-1 if a part does not exist).
+*/
+func (it *TableauAlgorithmIterator) standardFormColumnsOf(v symbolic.Variable) (int, int, error) {
+	// Setup
+	positiveIdx, negativeIdx := -1, -1
+
+	expr, found := it.mapFromOriginalVariablesToStandardFormVariables[v]
+	if !found {
+		return positiveIdx, negativeIdx, nil
+	}
+
+	scalarExpr, ok := expr.(symbolic.ScalarExpression)
+	if !ok {
+		return -1, -1, fmt.Errorf("the variable %v was not replaced by a scalar expression", v)
+	}
+
+	// Find the parts
+	coeffs := scalarExpr.LinearCoeff(it.initialTableau.Variables)
+	for jj := 0; jj < coeffs.Len(); jj++ {
+		switch {
+		case coeffs.AtVec(jj) > 0 && positiveIdx == -1:
+			positiveIdx = jj
+		case coeffs.AtVec(jj) < 0 && negativeIdx == -1:
+			negativeIdx = jj
+		}
+	}
+
+	return positiveIdx, negativeIdx, nil
+}
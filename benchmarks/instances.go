@@ -0,0 +1,295 @@
+/*
+Package benchmarks contains a family of linear programs and the helpers that the
+benchmarks in testing/benchmarks use to solve them with every algorithm and pivot
+rule, so that performance regressions are visible.
+
+The instances are:
+  - Klee–Minty cubes, on which Dantzig's rule makes an exponential number of pivots.
+  - Random dense and sparse feasible problems of a given size.
+  - Transportation and assignment problems.
+  - The 57-variable problem of gonum issue 1914 (see GonumBug1).
+
+Except for GonumBug1, every instance has the form max c^T x s.t. A x <= b, x >= 0 with
+b >= 0, so that the slack basis (from which the algorithms start) is feasible.
+*/
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+Instance
+Description:
+
+	A named problem of the benchmark family. HasEqualityConstraints is set if the
+	problem has equality constraints, which some algorithms do not support.
+*/
+type Instance struct {
+	Name                   string
+	Problem                *problem.OptimizationProblem
+	HasEqualityConstraints bool
+}
+
+/*
+KleeMinty
+Description:
+
+	Returns the Klee–Minty cube of dimension n:
+		max sum_j 2^(n-j) x_j
+		s.t. sum_{j<i} 2^(i-j+1) x_j + x_i <= 5^i	(i = 1, ..., n)
+		     x >= 0
+	whose optimum is x_n = 5^n (all other variables 0), with objective value 5^n.
+	Starting from the origin, Dantzig's rule visits all 2^n vertices of the cube.
+*/
+func KleeMinty(n int) Instance {
+	// Setup
+	prob := problem.NewProblem(fmt.Sprintf("KleeMinty%v", n))
+	x := addNonnegativeVariables(prob, n)
+
+	// Create the objective
+	c := make([]float64, n)
+	for jj := range c {
+		c[jj] = math.Pow(2, float64(n-1-jj))
+	}
+	prob.SetObjective(linearExpression(x, c), problem.SenseMaximize)
+
+	// Create the constraints
+	for ii := 0; ii < n; ii++ {
+		coeffs := make([]float64, ii+1)
+		for jj := 0; jj < ii; jj++ {
+			coeffs[jj] = math.Pow(2, float64(ii-jj+1))
+		}
+		coeffs[ii] = 1.0
+		prob.Constraints = append(
+			prob.Constraints,
+			linearExpression(x[:ii+1], coeffs).LessEq(math.Pow(5, float64(ii+1))),
+		)
+	}
+
+	return Instance{Name: fmt.Sprintf("klee-minty-%v", n), Problem: prob}
+}
+
+/*
+RandomDense
+Description:
+
+	Returns a random problem max c^T x s.t. A x <= b, x >= 0 with nConstraints
+	constraints and nVariables variables in which every entry of A is nonzero.
+	All entries of A, b and c are positive, so the problem is feasible and bounded.
+	The same seed always gives the same problem.
+*/
+func RandomDense(nConstraints, nVariables int, seed int64) Instance {
+	return Instance{
+		Name:    fmt.Sprintf("dense-%vx%v", nConstraints, nVariables),
+		Problem: randomProblem(nConstraints, nVariables, 1.0, seed),
+	}
+}
+
+/*
+RandomSparse
+Description:
+
+	Returns a random problem like RandomDense in which each entry of A is nonzero with
+	probability density (every row and every column has at least one nonzero entry).
+*/
+func RandomSparse(nConstraints, nVariables int, density float64, seed int64) Instance {
+	return Instance{
+		Name:    fmt.Sprintf("sparse-%vx%v", nConstraints, nVariables),
+		Problem: randomProblem(nConstraints, nVariables, density, seed),
+	}
+}
+
+/*
+randomProblem
+Description:
+
+	Creates the problem of RandomDense and RandomSparse: the nonzero entries of A are
+	in [0.1, 1.1), the entries of c are in [1, 2) and b_i is between one and two times
+	the number of nonzero entries in row i.
+*/
+func randomProblem(nConstraints, nVariables int, density float64, seed int64) *problem.OptimizationProblem {
+	// Setup
+	rng := rand.New(rand.NewSource(seed))
+	prob := problem.NewProblem(fmt.Sprintf("Random%vx%v", nConstraints, nVariables))
+	x := addNonnegativeVariables(prob, nVariables)
+
+	// Choose the nonzero entries of A (making sure that no row or column is empty)
+	isNonzero := make([][]bool, nConstraints)
+	columnIsEmpty := make([]bool, nVariables)
+	for jj := range columnIsEmpty {
+		columnIsEmpty[jj] = true
+	}
+	for ii := range isNonzero {
+		isNonzero[ii] = make([]bool, nVariables)
+		isNonzero[ii][rng.Intn(nVariables)] = true
+		for jj := range isNonzero[ii] {
+			isNonzero[ii][jj] = isNonzero[ii][jj] || rng.Float64() < density
+			columnIsEmpty[jj] = columnIsEmpty[jj] && !isNonzero[ii][jj]
+		}
+	}
+	for jj, isEmpty := range columnIsEmpty {
+		if isEmpty {
+			isNonzero[rng.Intn(nConstraints)][jj] = true
+		}
+	}
+
+	// Create the objective
+	c := make([]float64, nVariables)
+	for jj := range c {
+		c[jj] = 1.0 + rng.Float64()
+	}
+	prob.SetObjective(linearExpression(x, c), problem.SenseMaximize)
+
+	// Create the constraints
+	for ii := range isNonzero {
+		var vars []symbolic.Variable
+		var coeffs []float64
+		for jj, nonzero := range isNonzero[ii] {
+			if nonzero {
+				vars = append(vars, x[jj])
+				coeffs = append(coeffs, 0.1+rng.Float64())
+			}
+		}
+		rhs := float64(len(vars)) * (1.0 + rng.Float64())
+		prob.Constraints = append(prob.Constraints, linearExpression(vars, coeffs).LessEq(rhs))
+	}
+
+	return prob
+}
+
+/*
+Transportation
+Description:
+
+	Returns a random transportation problem with nSources sources and nSinks sinks:
+	x_ij is the amount shipped from source i to sink j, which earns a profit p_ij in [1, 10).
+		max sum_ij p_ij x_ij
+		s.t. sum_j x_ij <= s_i	(supply of source i)
+		     sum_i x_ij <= d_j	(demand of sink j)
+		     x >= 0
+	The supplies and demands are integers in [10, 50). (The classic form, which ships
+	the whole demand at minimum cost, needs equality or >= constraints, which the slack
+	basis does not satisfy.)
+*/
+func Transportation(nSources, nSinks int, seed int64) Instance {
+	// Setup
+	rng := rand.New(rand.NewSource(seed))
+	prob := problem.NewProblem(fmt.Sprintf("Transportation%vx%v", nSources, nSinks))
+	x := addNonnegativeVariables(prob, nSources*nSinks)
+
+	profits := make([]float64, len(x))
+	for kk := range profits {
+		profits[kk] = 1.0 + 9.0*rng.Float64()
+	}
+	prob.SetObjective(linearExpression(x, profits), problem.SenseMaximize)
+
+	// Create the supply and demand constraints
+	capacities := func(n int) []float64 {
+		out := make([]float64, n)
+		for ii := range out {
+			out[ii] = float64(10 + rng.Intn(40))
+		}
+		return out
+	}
+	addBipartiteConstraints(prob, x, nSources, nSinks, capacities(nSources), capacities(nSinks))
+
+	return Instance{Name: fmt.Sprintf("transportation-%vx%v", nSources, nSinks), Problem: prob}
+}
+
+/*
+Assignment
+Description:
+
+	Returns a random assignment problem of n agents and n tasks: x_ij is 1 if agent i
+	performs task j, which is worth w_ij in [1, 10).
+		max sum_ij w_ij x_ij
+		s.t. sum_j x_ij <= 1	(every agent performs at most one task)
+		     sum_i x_ij <= 1	(every task is performed by at most one agent)
+		     x >= 0
+	The constraint matrix is totally unimodular, so there is an optimal vertex with
+	x_ij in {0, 1}. The problem is highly degenerate.
+*/
+func Assignment(n int, seed int64) Instance {
+	// Setup
+	rng := rand.New(rand.NewSource(seed))
+	prob := problem.NewProblem(fmt.Sprintf("Assignment%v", n))
+	x := addNonnegativeVariables(prob, n*n)
+
+	weights := make([]float64, len(x))
+	for kk := range weights {
+		weights[kk] = 1.0 + 9.0*rng.Float64()
+	}
+	prob.SetObjective(linearExpression(x, weights), problem.SenseMaximize)
+
+	// Create the agent and task constraints
+	ones := make([]float64, n)
+	for ii := range ones {
+		ones[ii] = 1.0
+	}
+	addBipartiteConstraints(prob, x, n, n, ones, ones)
+
+	return Instance{Name: fmt.Sprintf("assignment-%v", n), Problem: prob}
+}
+
+/*
+addBipartiteConstraints
+Description:
+
+	Adds the constraints sum_j x_ij <= rowLimits[i] and sum_i x_ij <= columnLimits[j]
+	on the variables x_ij = x[i*nColumns+j].
+*/
+func addBipartiteConstraints(prob *problem.OptimizationProblem, x []symbolic.Variable, nRows, nColumns int, rowLimits, columnLimits []float64) {
+	ones := make([]float64, max(nRows, nColumns))
+	for ii := range ones {
+		ones[ii] = 1.0
+	}
+
+	for ii := 0; ii < nRows; ii++ {
+		row := x[ii*nColumns : (ii+1)*nColumns]
+		prob.Constraints = append(prob.Constraints, linearExpression(row, ones[:nColumns]).LessEq(rowLimits[ii]))
+	}
+	for jj := 0; jj < nColumns; jj++ {
+		column := make([]symbolic.Variable, nRows)
+		for ii := range column {
+			column[ii] = x[ii*nColumns+jj]
+		}
+		prob.Constraints = append(prob.Constraints, linearExpression(column, ones[:nRows]).LessEq(columnLimits[jj]))
+	}
+}
+
+/*
+addNonnegativeVariables
+Description:
+
+	Adds n continuous variables with the bounds [0, +Inf) to the problem.
+*/
+func addNonnegativeVariables(prob *problem.OptimizationProblem, n int) []symbolic.Variable {
+	out := make([]symbolic.Variable, n)
+	for ii := range out {
+		out[ii] = prob.AddVariableClassic(0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	}
+	return out
+}
+
+/*
+linearExpression
+Description:
+
+	Returns the polynomial sum_k coeffs[k] * vars[k] (which only contains the
+	given terms, however many variables the problem has).
+*/
+func linearExpression(vars []symbolic.Variable, coeffs []float64) symbolic.Polynomial {
+	out := symbolic.Polynomial{}
+	for k, v := range vars {
+		monomial := v.ToMonomial()
+		monomial.Coefficient = coeffs[k]
+		out.Monomials = append(out.Monomials, monomial)
+	}
+	return out
+}
@@ -0,0 +1,175 @@
+package benchmarks
+
+import (
+	"testing"
+	"time"
+
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/simplex/algorithms"
+	"github.com/MatProGo-dev/simplex/algorithms/tableau/selection"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+)
+
+// IterationLimit is the iteration limit of every benchmark solve.
+const IterationLimit = 1000
+
+// VerificationTolerance is the largest constraint or bound violation that is accepted
+// in the solution of the first solve of every benchmark.
+const VerificationTolerance = 1e-6
+
+/*
+Configuration
+Description:
+
+	An algorithm and pivot rule with which the instances are solved.
+	SelectionRule is the rule passed to the solver (nil for the algorithms that
+	select their pivots themselves, in which case PivotRule names their rule).
+	SupportsEqualityConstraints is false for the algorithms that return an error or
+	a wrong solution for problems with equality constraints (currently all of them,
+	since they start from the slack basis, which does not cover the equality rows).
+*/
+type Configuration struct {
+	Algorithm                   algorithms.AlgorithmType
+	PivotRule                   string
+	SelectionRule               selection.SelectionRule
+	SupportsEqualityConstraints bool
+}
+
+/*
+Name
+Description:
+
+	Returns the name of the configuration as used in the names of the benchmarks
+	(e.g., "tableau/bland").
+*/
+func (config Configuration) Name() string {
+	return config.Algorithm.String() + "/" + config.PivotRule
+}
+
+/*
+Configurations
+Description:
+
+	Returns a configuration for every algorithm type and each pivot rule that
+	the algorithm supports:
+	- the tableau algorithm with every selection rule (Bland's Rule),
+	- the exact tableau algorithm, which always uses Bland's Rule, and
+	- the sparse revised simplex algorithm, which always uses Dantzig's rule
+	  (the largest reduced cost).
+*/
+func Configurations() []Configuration {
+	return []Configuration{
+		{
+			Algorithm:     algorithms.TypeNaiveTableau,
+			PivotRule:     "bland",
+			SelectionRule: selection.BlandsRule{},
+		},
+		{
+			Algorithm: algorithms.TypeExactTableau,
+			PivotRule: "bland",
+		},
+		{
+			Algorithm: algorithms.TypeSparseRevised,
+			PivotRule: "dantzig",
+		},
+	}
+}
+
+/*
+Run
+Description:
+
+	Solves the instance b.N times with the configuration and reports, besides the time
+	and the allocations of a whole solve:
+	- pivots/op: the number of pivots of a solve and
+	- pivots/s: the number of pivots per second of the time spent in the pivoting phases
+	  (see simplex_solution.Statistics), which leaves out the construction of the initial
+	  tableau so that a regression in the pivots is not hidden by the setup.
+	The benchmark is skipped if the configuration does not support the instance and
+	fails if a solve returns an error or ends with a status other than OPTIMAL (e.g.,
+	ITERATION_LIMIT because the pivot rule cycles), or if the solution of the first solve
+	violates a constraint or bound by more than VerificationTolerance (see
+	simplex_solution.SimplexSolution.Verify). The verification is not timed.
+*/
+func Run(b *testing.B, instance Instance, config Configuration) {
+	// Input Processing
+	if instance.HasEqualityConstraints && !config.SupportsEqualityConstraints {
+		b.Skipf("%v does not support equality constraints", config.Algorithm)
+	}
+
+	// Setup
+	solver := simplexSolver.New("benchmark")
+	solver.Algorithm = config.Algorithm
+	solver.SelectionRule = config.SelectionRule
+	solver.IterationLimit = IterationLimit
+
+	// Solve the instance b.N times
+	b.ReportAllocs()
+	b.ResetTimer()
+	pivots, pivotTime := 0, time.Duration(0)
+	for ii := 0; ii < b.N; ii++ {
+		sol, err := solver.Solve(*instance.Problem)
+		if err != nil {
+			b.Fatalf("Solving %v with %v failed: %v", instance.Name, config.Name(), err)
+		}
+		if sol.Status != solution_status.OPTIMAL {
+			b.Fatalf("Expected %v to be solved to optimality by %v, but got status %v", instance.Name, config.Name(), sol.Status)
+		}
+		if ii == 0 {
+			b.StopTimer()
+			verify(b, instance, config, sol)
+			b.StartTimer()
+		}
+
+		pivots += sol.Statistics.Pivots()
+		for _, phase := range sol.Statistics.Phases {
+			pivotTime += phase.Time
+		}
+	}
+
+	// Report the pivots
+	b.ReportMetric(float64(pivots)/float64(b.N), "pivots/op")
+	if pivotTime > 0 {
+		b.ReportMetric(float64(pivots)/pivotTime.Seconds(), "pivots/s")
+	}
+}
+
+/*
+RunAll
+Description:
+
+	Runs a sub-benchmark (see Run) for every instance and configuration,
+	named "<instance>/<algorithm>/<pivot rule>".
+*/
+func RunAll(b *testing.B, instances ...Instance) {
+	for _, instance := range instances {
+		b.Run(instance.Name, func(b *testing.B) {
+			for _, config := range Configurations() {
+				b.Run(config.Name(), func(b *testing.B) {
+					Run(b, instance, config)
+				})
+			}
+		})
+	}
+}
+
+/*
+verify
+Description:
+
+	Fails the benchmark if the solution of the instance cannot be verified or if it
+	violates a constraint or bound by more than VerificationTolerance.
+*/
+func verify(b *testing.B, instance Instance, config Configuration, sol simplex_solution.SimplexSolution) {
+	report, err := sol.Verify()
+	if err != nil {
+		b.Fatalf("Verifying the solution of %v by %v failed: %v", instance.Name, config.Name(), err)
+	}
+	if !report.PrimalFeasibleWithin(VerificationTolerance) {
+		b.Fatalf(
+			"Expected the solution of %v by %v to be feasible, but it violates a constraint by %v and a bound by %v",
+			instance.Name, config.Name(), report.MaxPrimalViolation, report.MaxBoundViolation,
+		)
+	}
+}
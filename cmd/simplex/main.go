@@ -0,0 +1,292 @@
+/*
+Command simplex reads a linear program from an LP, MPS or JSON file and solves it.
+
+Usage:
+
+	simplex [flags] model-file
+
+The format of the file is chosen by its extension (.lp, .mps or .json) unless
+-format is given. The status, the objective, the values of the variables and the
+duals of the constraints are written to standard output as text or as JSON (-output json).
+With -trace, every pivot is reported on standard error and with -latex FILE the
+tableau of every iteration is written to FILE as a LaTeX document.
+Numerical warnings (e.g., a refactorization of the tableau that changed the
+solution) are reported on standard error.
+The final basis can be saved with -basis-out FILE and used to warm start a later
+solve of the same model with -basis-in FILE (both in the JSON encoding of json_format).
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MatProGo-dev/simplex/algorithms"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/algorithms/tableau/selection"
+	"github.com/MatProGo-dev/simplex/formats"
+	json_format "github.com/MatProGo-dev/simplex/formats/json"
+	lp_format "github.com/MatProGo-dev/simplex/formats/lp"
+	mps_format "github.com/MatProGo-dev/simplex/formats/mps"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+)
+
+// options contains the values of the command line flags.
+type options struct {
+	format              string
+	algorithm           string
+	pivotRule           string
+	iterationLimit      int
+	pivotTolerance      float64
+	optimalityTolerance float64
+	refactorization     int
+	scaling             string
+	output              string
+	trace               bool
+	latexFile           string
+	basisIn             string
+	basisOut            string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+/*
+run
+Description:
+
+	Parses the arguments, solves the model and writes the results.
+	Returns the exit code of the command: 0 if the model was solved (whatever
+	the solution status), 1 if an error occurred and 2 if the arguments are invalid.
+*/
+func run(args []string, stdout, stderr io.Writer) int {
+	// Parse the flags
+	var opts options
+	flags := flag.NewFlagSet("simplex", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&opts.format, "format", "", "format of the model file: lp, mps, fixed-mps or json (default: from the file extension)")
+	flags.StringVar(&opts.algorithm, "algorithm", algorithms.TypeNaiveTableau.String(), "algorithm used to solve the model: tableau, exact-tableau or sparse-revised")
+	flags.StringVar(&opts.pivotRule, "pivot", "bland", "rule used to select the pivots")
+	flags.IntVar(&opts.iterationLimit, "iterations", 1000, "maximum number of pivots")
+	flags.Float64Var(&opts.pivotTolerance, "pivot-tol", 0.0, "magnitude below which tableau entries are treated as zero (0 selects the default)")
+	flags.Float64Var(&opts.optimalityTolerance, "opt-tol", 0.0, "amount by which a reduced cost may be negative at an optimum")
+	flags.IntVar(&opts.refactorization, "refactor", 0, "number of pivots between refactorizations of the tableau (0 selects the default, negative disables them)")
+	flags.StringVar(&opts.scaling, "scaling", tableau_algorithm1.NoScaling.String(), "scaling of the model: none, geometric, equilibration or geometric+equilibration")
+	flags.StringVar(&opts.output, "output", "text", "output format: text or json")
+	flags.BoolVar(&opts.trace, "trace", false, "report every pivot on standard error")
+	flags.StringVar(&opts.latexFile, "latex", "", "write the tableau of every iteration to this file as LaTeX")
+	flags.StringVar(&opts.basisIn, "basis-in", "", "start from the basis in this JSON file")
+	flags.StringVar(&opts.basisOut, "basis-out", "", "write the final basis to this JSON file")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: simplex [flags] model-file\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+	if opts.output != "text" && opts.output != "json" {
+		fmt.Fprintf(stderr, "simplex: unknown output format \"%v\"\n", opts.output)
+		return 2
+	}
+
+	// Read the model
+	model, err := readModel(flags.Arg(0), opts.format)
+	if err != nil {
+		fmt.Fprintf(stderr, "simplex: %v\n", err)
+		return 1
+	}
+
+	// Solve
+	sol, err := solve(model, opts, stderr)
+	if err != nil {
+		fmt.Fprintf(stderr, "simplex: %v\n", err)
+		return 1
+	}
+	for _, warning := range sol.Warnings {
+		fmt.Fprintf(stderr, "simplex: warning: %v\n", warning)
+	}
+
+	if opts.basisOut != "" {
+		err = writeBasis(opts.basisOut, &sol, model)
+		if err != nil {
+			fmt.Fprintf(stderr, "simplex: %v\n", err)
+			return 1
+		}
+	}
+
+	// Write the results
+	if opts.output == "json" {
+		err = writeJSON(stdout, &sol, model)
+	} else {
+		err = writeText(stdout, &sol, model)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "simplex: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+/*
+readModel
+Description:
+
+	Reads the model from the file in the given format (or in the format
+	given by the file's extension if format is empty).
+*/
+func readModel(filename string, format string) (*formats.Model, error) {
+	// Choose the format
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(filename)) {
+		case ".lp":
+			format = "lp"
+		case ".mps":
+			format = "mps"
+		case ".json":
+			format = "json"
+		default:
+			return nil, fmt.Errorf("cannot tell the format of \"%v\" from its extension (use -format)", filename)
+		}
+	}
+
+	switch format {
+	case "lp":
+		return lp_format.ReadFile(filename)
+	case "mps":
+		return mps_format.ReadFile(filename, mps_format.Free)
+	case "fixed-mps":
+		return mps_format.ReadFile(filename, mps_format.Fixed)
+	case "json":
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		return json_format.UnmarshalModel(data)
+	}
+	return nil, fmt.Errorf("unknown model format \"%v\"", format)
+}
+
+/*
+solve
+Description:
+
+	Creates the solver described by the options and solves the model's problem.
+	If the pivots are traced or written as LaTeX, the solver records the history
+	of the tableau algorithm; otherwise the tableau is pivoted in place.
+*/
+func solve(model *formats.Model, opts options, stderr io.Writer) (simplex_solution.SimplexSolution, error) {
+	// Setup
+	algoType, err := algorithms.ToAlgorithmType(opts.algorithm)
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, err
+	}
+
+	solver := simplexSolver.New("simplex")
+	solver.Algorithm = algoType
+	solver.IterationLimit = opts.iterationLimit
+	solver.PivotTolerance = opts.pivotTolerance
+	solver.OptimalityTolerance = opts.optimalityTolerance
+	solver.RefactorizationFrequency = opts.refactorization
+	solver.InPlacePivoting = !opts.trace && opts.latexFile == ""
+	solver.Scaling, err = tableau_algorithm1.ToScalingMethod(opts.scaling)
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, err
+	}
+
+	if opts.basisIn != "" {
+		data, err := os.ReadFile(opts.basisIn)
+		if err != nil {
+			return simplex_solution.SimplexSolution{}, err
+		}
+		solver.InitialBasis, err = json_format.UnmarshalBasis(data, model)
+		if err != nil {
+			return simplex_solution.SimplexSolution{}, err
+		}
+	}
+
+	switch opts.pivotRule {
+	case "bland":
+		solver.SelectionRule = selection.BlandsRule{Tolerance: opts.pivotTolerance}
+	default:
+		return simplex_solution.SimplexSolution{}, fmt.Errorf("unknown pivot rule \"%v\"", opts.pivotRule)
+	}
+
+	if !opts.trace && opts.latexFile == "" {
+		return solver.Solve(*model.Problem)
+	}
+
+	// Record the history of the tableau algorithm
+	if algoType != algorithms.TypeNaiveTableau {
+		return simplex_solution.SimplexSolution{}, fmt.Errorf("-trace and -latex are only supported by the tableau algorithm")
+	}
+	sol, history, err := solver.SolveWithHistory(*model.Problem)
+
+	if opts.trace {
+		for ii := 1; ii < len(history); ii++ {
+			traceStep(stderr, history[ii-1], history[ii])
+		}
+	}
+	if err != nil {
+		return sol, err
+	}
+
+	if opts.latexFile != "" {
+		latex, err := tableau_algorithm1.HistoryToLaTeX(history)
+		if err != nil {
+			return simplex_solution.SimplexSolution{}, err
+		}
+		err = os.WriteFile(opts.latexFile, []byte(latex), 0o644)
+		if err != nil {
+			return simplex_solution.SimplexSolution{}, err
+		}
+	}
+
+	return sol, nil
+}
+
+/*
+writeBasis
+Description:
+
+	Writes the final basis of the solution to the file as JSON.
+*/
+func writeBasis(filename string, sol *simplex_solution.SimplexSolution, model *formats.Model) error {
+	if sol.Basis == nil {
+		return fmt.Errorf("the solution does not describe its basis")
+	}
+	data, err := json_format.MarshalBasis(sol.Basis, model)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0o644)
+}
+
+// traceStep reports the pivot that led from one state to the next.
+func traceStep(w io.Writer, before, after tableau_algorithm1.TableauAlgorithmState) {
+	enteringVarIdx, exitingVarIdx, err := tableau_algorithm1.PivotBetween(before, after)
+	if err != nil {
+		fmt.Fprintf(w, "iteration %v: %v\n", after.IterationCount, err)
+		return
+	}
+
+	variables := after.Tableau.Variables
+	fmt.Fprintf(
+		w,
+		"iteration %v: %v enters, %v leaves\n",
+		after.IterationCount,
+		variables[enteringVarIdx].Name,
+		variables[exitingVarIdx].Name,
+	)
+}
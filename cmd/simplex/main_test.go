@@ -0,0 +1,257 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	json_format "github.com/MatProGo-dev/simplex/formats/json"
+)
+
+// The encodings of test problem 5 (whose optimal objective is 9375) in every format
+const (
+	problem5LP   = "../../testing/formats/lp/testdata/problem5.lp"
+	problem5MPS  = "../../testing/formats/mps/testdata/problem5.mps"
+	problem5JSON = "../../testing/formats/json/testdata/problem5.json"
+)
+
+/*
+runCommand
+Description:
+
+	Runs the command with the given arguments and returns its exit code and
+	what it wrote to standard output and standard error.
+*/
+func runCommand(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+/*
+TestRun_Formats1
+Description:
+
+	Verifies that test problem 5 is read from LP, MPS and JSON files (whose format
+	is chosen by the extension) and that the text output reports its optimum.
+*/
+func TestRun_Formats1(t *testing.T) {
+	for _, filename := range []string{problem5LP, problem5MPS, problem5JSON} {
+		// Test
+		code, stdout, stderr := runCommand(filename)
+
+		// Verify
+		if code != 0 {
+			t.Errorf("%v: Expected exit code 0, but got %v (%v)", filename, code, stderr)
+			continue
+		}
+		for _, expected := range []string{"Status:      OPTIMAL", "Objective:   9375", "Iterations:  2"} {
+			if !strings.Contains(stdout, expected) {
+				t.Errorf("%v: Expected the output to contain %q, but got:\n%v", filename, expected, stdout)
+			}
+		}
+	}
+}
+
+/*
+TestRun_Formats2
+Description:
+
+	Verifies that a file whose extension does not name a format is rejected
+	unless the format is given with -format.
+*/
+func TestRun_Formats2(t *testing.T) {
+	// Setup
+	data, err := os.ReadFile(problem5LP)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	filename := filepath.Join(t.TempDir(), "problem5.txt")
+	err = os.WriteFile(filename, data, 0o644)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	code, _, stderr := runCommand(filename)
+	codeWithFormat, stdout, _ := runCommand("-format", "lp", filename)
+
+	// Verify
+	if code != 1 || !strings.Contains(stderr, "use -format") {
+		t.Errorf("Expected exit code 1 and a hint to use -format, but got %v (%v)", code, stderr)
+	}
+	if codeWithFormat != 0 || !strings.Contains(stdout, "Objective:   9375") {
+		t.Errorf("Expected exit code 0 and the objective 9375, but got %v:\n%v", codeWithFormat, stdout)
+	}
+}
+
+/*
+TestRun_Output1
+Description:
+
+	Verifies that -output json writes the solution in the JSON encoding of
+	json_format, with the duals of the constraints named as in the model.
+*/
+func TestRun_Output1(t *testing.T) {
+	// Test
+	code, stdout, stderr := runCommand("-output", "json", problem5LP)
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, but got %v (%v)", code, stderr)
+	}
+
+	var doc json_format.SolutionDocument
+	err := json.Unmarshal([]byte(stdout), &doc)
+
+	// Verify
+	if err != nil {
+		t.Fatalf("Expected the output to be JSON, but got: %v\n%v", err, stdout)
+	}
+	if doc.Status != "OPTIMAL" || math.Abs(doc.Objective-9375.0) > 1e-9 {
+		t.Errorf("Expected the objective 9375 (OPTIMAL), but got %v (%v)", doc.Objective, doc.Status)
+	}
+	if math.Abs(doc.Variables["x1"]-125.0) > 1e-9 || math.Abs(doc.Variables["x2"]-300.0) > 1e-9 {
+		t.Errorf("Expected x1 = 125 and x2 = 300, but got %v", doc.Variables)
+	}
+	if len(doc.Duals) != 4 || doc.Duals[2].Name != "c3" || math.Abs(doc.Duals[2].Value-3.75) > 1e-9 {
+		t.Errorf("Expected the dual of c3 to be 3.75, but got %v", doc.Duals)
+	}
+}
+
+/*
+TestRun_Trace1
+Description:
+
+	Verifies that -trace reports every pivot on standard error and that -latex
+	writes the tableau of every iteration to the given file.
+*/
+func TestRun_Trace1(t *testing.T) {
+	// Setup
+	latexFile := filepath.Join(t.TempDir(), "history.tex")
+
+	// Test
+	code, stdout, stderr := runCommand("-trace", "-latex", latexFile, problem5LP)
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, but got %v (%v)", code, stderr)
+	}
+	latex, err := os.ReadFile(latexFile)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if !strings.Contains(stdout, "Objective:   9375") {
+		t.Errorf("Expected the objective 9375, but got:\n%v", stdout)
+	}
+	for _, expected := range []string{"iteration 1: x_1 enters, x_3 (slack) leaves", "iteration 2: x_0 enters, x_4 (slack) leaves"} {
+		if !strings.Contains(stderr, expected) {
+			t.Errorf("Expected the trace to contain %q, but got:\n%v", expected, stderr)
+		}
+	}
+	for _, expected := range []string{"% Iteration 0", "% Iteration 2", "\\begin{tabular}"} {
+		if !strings.Contains(string(latex), expected) {
+			t.Errorf("Expected the LaTeX output to contain %q, but got:\n%s", expected, latex)
+		}
+	}
+}
+
+/*
+TestRun_Basis1
+Description:
+
+	Verifies that the basis saved with -basis-out can be read back with -basis-in
+	and that a solve started from the optimal basis needs no pivots.
+*/
+func TestRun_Basis1(t *testing.T) {
+	// Setup
+	basisFile := filepath.Join(t.TempDir(), "basis.json")
+
+	// Test
+	code, _, stderr := runCommand("-basis-out", basisFile, problem5LP)
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, but got %v (%v)", code, stderr)
+	}
+	code, stdout, stderr := runCommand("-basis-in", basisFile, problem5LP)
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, but got %v (%v)", code, stderr)
+	}
+
+	// Verify
+	data, err := os.ReadFile(basisFile)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	var doc json_format.BasisDocument
+	err = json.Unmarshal(data, &doc)
+	if err != nil {
+		t.Fatalf("Expected the basis file to be JSON, but got: %v\n%s", err, data)
+	}
+
+	if !strings.Contains(stdout, "Objective:   9375") || !strings.Contains(stdout, "Iterations:  0") {
+		t.Errorf("Expected the objective 9375 after 0 iterations, but got:\n%v", stdout)
+	}
+}
+
+/*
+TestRun_ExitCodes1
+Description:
+
+	Verifies that invalid arguments give the exit code 2 and that models, bases
+	and options that cannot be used give the exit code 1.
+*/
+func TestRun_ExitCodes1(t *testing.T) {
+	// Setup
+	dir := t.TempDir()
+	badModel := filepath.Join(dir, "bad.lp")
+	badBasis := filepath.Join(dir, "bad.json")
+	eqModel := filepath.Join(dir, "eq.lp")
+	geModel := filepath.Join(dir, "ge.lp")
+	for filename, content := range map[string]string{
+		badModel: "Maximize\n obj: 2 x +\nSubject To\n",
+		badBasis: "{\"version\": 1",
+		eqModel:  "Maximize\n obj: x + 2 y\nSubject To\n c1: x + y = 4\n c2: x <= 3\nEnd\n",
+		geModel:  "Minimize\n obj: x + y\nSubject To\n c1: x + y >= 2\n c2: x <= 3\nEnd\n",
+	} {
+		err := os.WriteFile(filename, []byte(content), 0o644)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+	}
+
+	testCases := []struct {
+		Name         string
+		Args         []string
+		ExpectedCode int
+	}{
+		{"no model file", []string{}, 2},
+		{"two model files", []string{problem5LP, problem5MPS}, 2},
+		{"unknown flag", []string{"-unknown", problem5LP}, 2},
+		{"unknown output format", []string{"-output", "xml", problem5LP}, 2},
+		{"missing model file", []string{filepath.Join(dir, "missing.lp")}, 1},
+		{"malformed model file", []string{badModel}, 1},
+		{"unknown model format", []string{"-format", "csv", problem5LP}, 1},
+		{"unknown algorithm", []string{"-algorithm", "interior-point", problem5LP}, 1},
+		{"unknown pivot rule", []string{"-pivot", "steepest-edge", problem5LP}, 1},
+		{"malformed basis file", []string{"-basis-in", badBasis, problem5LP}, 1},
+		{"equality constraint", []string{eqModel}, 1},
+		{">= constraint", []string{geModel}, 1},
+		{"traced equality constraint", []string{"-trace", eqModel}, 1},
+		{"trace of the revised algorithm", []string{"-algorithm", "sparse-revised", "-trace", problem5LP}, 1},
+	}
+
+	for _, tc := range testCases {
+		// Test
+		code, _, stderr := runCommand(tc.Args...)
+
+		// Verify
+		if code != tc.ExpectedCode {
+			t.Errorf("%v: Expected exit code %v, but got %v (%v)", tc.Name, tc.ExpectedCode, code, stderr)
+		}
+		if stderr == "" {
+			t.Errorf("%v: Expected a message on standard error, but got none", tc.Name)
+		}
+	}
+}
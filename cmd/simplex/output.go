@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/MatProGo-dev/simplex/formats"
+	json_format "github.com/MatProGo-dev/simplex/formats/json"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+)
+
+/*
+writeText
+Description:
+
+	Writes the status, the objective, the values of the variables and the
+	duals of the constraints as aligned text.
+*/
+func writeText(w io.Writer, sol *simplex_solution.SimplexSolution, model *formats.Model) error {
+	// Setup
+	doc, err := json_format.NewSolutionDocument(sol, model)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	// Summary
+	fmt.Fprintf(tw, "Status:\t%v\n", doc.Status)
+	fmt.Fprintf(tw, "Objective:\t%v\n", formatValue(doc.Objective))
+	fmt.Fprintf(tw, "Iterations:\t%v\n", doc.Iterations)
+
+	// Variables (in the order of the model)
+	fmt.Fprintf(tw, "\nVariable\tValue\tReduced Cost\n")
+	for _, name := range model.VariableNames() {
+		reducedCost := "-"
+		if value, found := doc.ReducedCosts[name]; found {
+			reducedCost = formatValue(value)
+		}
+		fmt.Fprintf(tw, "%v\t%v\t%v\n", name, formatValue(doc.Variables[name]), reducedCost)
+	}
+
+	// Duals
+	if len(doc.Duals) > 0 {
+		fmt.Fprintf(tw, "\nConstraint\tDual\n")
+		for _, dual := range doc.Duals {
+			fmt.Fprintf(tw, "%v\t%v\n", dual.Name, formatValue(dual.Value))
+		}
+	}
+
+	return tw.Flush()
+}
+
+/*
+writeJSON
+Description:
+
+	Writes the solution in the JSON encoding of the json_format package.
+*/
+func writeJSON(w io.Writer, sol *simplex_solution.SimplexSolution, model *formats.Model) error {
+	data, err := json_format.MarshalSolution(sol, model)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// formatValue writes a value with as many digits as are needed to read it back exactly.
+func formatValue(value float64) string {
+	if value == 0 {
+		return "0" // Avoids writing -0
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,227 @@
+/*
+Package duality builds the dual of a linear problem.
+
+For the primal problem
+
+	maximize (minimize)  c^T x + c0
+	subject to           a_k^T x (<=, >= or ==) b_k      for every scalar constraint k
+	                     l <= x <= u
+
+the dual has one variable y_k per scalar constraint and one constraint per primal variable:
+
+	minimize (maximize)  sum_k b_k y_k + c0
+	subject to           sum_k a_kj y_k (>=, <= or ==) c_j   for every primal variable x_j
+
+The signs are chosen so that the value of y_k at an optimum is the rate of change of the
+primal optimum with respect to b_k, i.e. the dual value that the solver reports for
+constraint k (for a maximization, y_k >= 0 for <= constraints and y_k <= 0 for >= constraints;
+for a minimization, the other way around).
+A primal variable with a lower bound of 0 (an upper bound of 0) makes its dual constraint
+an inequality; other finite bounds are treated as the constraints x_j >= l_j and x_j <= u_j
+and get dual variables of their own. The dual constraints of free variables are equalities.
+*/
+package duality
+
+import (
+	"fmt"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+Result
+Description:
+
+	The dual of a problem and the mapping between the primal and the dual.
+	- Problem: the dual problem.
+	- ConstraintVariables: the dual variable of each scalar constraint of the primal problem
+	  (in the order given by utils.ExtractScalarConstraints).
+	- LowerBoundVariables, UpperBoundVariables: the dual variables of the finite variable bounds
+	  that are treated as constraints (by primal variable ID).
+	- VariableConstraints: the index of the dual constraint (in the order given by
+	  utils.ExtractScalarConstraints) of each primal variable (by ID).
+*/
+type Result struct {
+	Problem             *problem.OptimizationProblem
+	ConstraintVariables []symbolic.Variable
+	LowerBoundVariables map[uint64]symbolic.Variable
+	UpperBoundVariables map[uint64]symbolic.Variable
+	VariableConstraints map[uint64]int
+}
+
+/*
+ConstraintOf
+Description:
+
+	Returns the index of the primal scalar constraint whose dual variable is v
+	(or -1 if v is not the dual variable of a constraint).
+*/
+func (r *Result) ConstraintOf(v symbolic.Variable) int {
+	for kk, y := range r.ConstraintVariables {
+		if y.ID == v.ID {
+			return kk
+		}
+	}
+	return -1
+}
+
+/*
+dualRow
+Description:
+
+	A row a^T x (sense) b of the primal problem that gets a dual variable.
+*/
+type dualRow struct {
+	coeffs []float64
+	sense  symbolic.ConstrSense
+	rhs    float64
+	name   string
+}
+
+/*
+Dual
+Description:
+
+	Builds the dual of the linear problem prob (see the package documentation).
+*/
+func Dual(prob *problem.OptimizationProblem) (*Result, error) {
+	// Input Processing
+	if prob == nil {
+		return nil, fmt.Errorf("Dual: the problem cannot be nil")
+	}
+	objective, ok := prob.Objective.Expression.(symbolic.ScalarExpression)
+	if !ok || !symbolic.IsLinear(objective) {
+		return nil, fmt.Errorf("Dual: the objective is not a linear scalar expression")
+	}
+
+	// Setup
+	nVariables := len(prob.Variables)
+	c := objective.LinearCoeff(prob.Variables)
+
+	// sense is +1 for maximizations and -1 for minimizations
+	sense := 1.0
+	dualSense := problem.SenseMinimize
+	if prob.Objective.Sense != problem.SenseMaximize {
+		sense, dualSense = -1.0, problem.SenseMaximize
+	}
+
+	// Collect the rows: the scalar constraints and then the bounds
+	rows := []dualRow{}
+	for kk, constraint := range utils.ExtractScalarConstraints(prob.Constraints) {
+		expr, ok := constraint.Left().Minus(constraint.Right()).(symbolic.ScalarExpression)
+		if !ok || !symbolic.IsLinear(expr) {
+			return nil, fmt.Errorf("Dual: the constraint %v is not linear", kk)
+		}
+		a := expr.LinearCoeff(prob.Variables)
+		rows = append(rows, dualRow{
+			coeffs: a.RawVector().Data,
+			sense:  constraint.ConstrSense(),
+			rhs:    -expr.Constant(),
+			name:   fmt.Sprintf("y_%v", kk),
+		})
+	}
+	nConstraints := len(rows)
+
+	// Each variable's dual constraint is an equality unless one of its bounds is 0
+	// (which is then not treated as a row)
+	relations := make([]symbolic.ConstrSense, nVariables)
+	boundRows := map[int]uint64{}
+	for jj, v := range prob.Variables {
+		relations[jj] = symbolic.SenseEqual
+		lowerIsRow, upperIsRow := formats.IsFinite(v.Lower), formats.IsFinite(v.Upper)
+		switch {
+		case v.Lower == 0:
+			relations[jj], lowerIsRow = symbolic.SenseGreaterThanEqual, false
+		case v.Upper == 0:
+			relations[jj], upperIsRow = symbolic.SenseLessThanEqual, false
+		}
+
+		unit := make([]float64, nVariables)
+		unit[jj] = 1.0
+		if lowerIsRow {
+			boundRows[len(rows)] = v.ID
+			rows = append(rows, dualRow{unit, symbolic.SenseGreaterThanEqual, v.Lower, fmt.Sprintf("y_lower_%v", v.Name)})
+		}
+		if upperIsRow {
+			boundRows[len(rows)] = v.ID
+			rows = append(rows, dualRow{unit, symbolic.SenseLessThanEqual, v.Upper, fmt.Sprintf("y_upper_%v", v.Name)})
+		}
+	}
+
+	// Create the dual variables (for a maximization, y >= 0 for <= rows and y <= 0 for >= rows)
+	dual := problem.NewProblem(fmt.Sprintf("Dual of %v", prob.Name))
+	out := &Result{
+		Problem:             dual,
+		LowerBoundVariables: map[uint64]symbolic.Variable{},
+		UpperBoundVariables: map[uint64]symbolic.Variable{},
+		VariableConstraints: map[uint64]int{},
+	}
+
+	inf := symbolic.Infinity.Constant()
+	y := make([]symbolic.Variable, len(rows))
+	b := make([]float64, len(rows))
+	for ii, row := range rows {
+		lower, upper := -inf, inf
+		switch {
+		case row.sense == symbolic.SenseLessThanEqual && sense > 0, row.sense == symbolic.SenseGreaterThanEqual && sense < 0:
+			lower = 0
+		case row.sense == symbolic.SenseLessThanEqual, row.sense == symbolic.SenseGreaterThanEqual:
+			upper = 0
+		}
+
+		y[ii] = dual.AddVariableClassic(lower, upper, symbolic.Continuous)
+		y[ii].Name = row.name
+		dual.Variables[len(dual.Variables)-1].Name = row.name
+		b[ii] = row.rhs
+
+		switch {
+		case ii < nConstraints:
+			out.ConstraintVariables = append(out.ConstraintVariables, y[ii])
+		case row.sense == symbolic.SenseGreaterThanEqual:
+			out.LowerBoundVariables[boundRows[ii]] = y[ii]
+		default:
+			out.UpperBoundVariables[boundRows[ii]] = y[ii]
+		}
+	}
+
+	// Objective
+	dual.SetObjective(formats.LinearExpression(b, y, objective.Constant()), dualSense)
+
+	// One constraint per primal variable: sum_i a_ij y_i (relation) c_j, where the relation
+	// of a variable with a lower bound of 0 is >= for maximizations and <= for minimizations
+	for jj, v := range prob.Variables {
+		coeffs := make([]float64, len(rows))
+		for ii, row := range rows {
+			coeffs[ii] = row.coeffs[jj]
+		}
+		lhs := formats.LinearExpression(coeffs, y, 0)
+
+		relation := relations[jj]
+		if sense < 0 {
+			switch relation {
+			case symbolic.SenseGreaterThanEqual:
+				relation = symbolic.SenseLessThanEqual
+			case symbolic.SenseLessThanEqual:
+				relation = symbolic.SenseGreaterThanEqual
+			}
+		}
+
+		var constraint symbolic.Constraint
+		switch relation {
+		case symbolic.SenseGreaterThanEqual:
+			constraint = lhs.GreaterEq(c.AtVec(jj))
+		case symbolic.SenseLessThanEqual:
+			constraint = lhs.LessEq(c.AtVec(jj))
+		default:
+			constraint = lhs.Eq(c.AtVec(jj))
+		}
+
+		out.VariableConstraints[v.ID] = len(dual.Constraints)
+		dual.Constraints = append(dual.Constraints, constraint)
+	}
+
+	return out, nil
+}
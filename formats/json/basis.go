@@ -0,0 +1,160 @@
+package json_format
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MatProGo-dev/simplex/formats"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+)
+
+/*
+BasisDocument
+Description:
+
+	The JSON encoding of a simplex_solution.Basis. The statuses are written by name
+	("basic", "at_lower", "at_upper" or "free"), the variables are referred to by name
+	and the constraints are listed in the order of the model's scalar constraints
+	(see formats.Model.ScalarConstraintNames).
+*/
+type BasisDocument struct {
+	Version     int               `json:"version"`
+	Variables   map[string]string `json:"variables"`
+	Constraints []NamedStatus     `json:"constraints"`
+}
+
+/*
+NamedStatus
+Description:
+
+	The basis status of a named constraint.
+*/
+type NamedStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+/*
+NewBasisDocument
+Description:
+
+	Converts a basis of the model's problem into a BasisDocument.
+*/
+func NewBasisDocument(basis *simplex_solution.Basis, model *formats.Model) (BasisDocument, error) {
+	// Input Processing
+	if basis == nil {
+		return BasisDocument{}, fmt.Errorf("NewBasisDocument: the basis cannot be nil")
+	}
+	if model == nil || model.Problem == nil {
+		return BasisDocument{}, fmt.Errorf("NewBasisDocument: the model cannot be nil")
+	}
+
+	names := model.ScalarConstraintNames()
+	if len(names) != len(basis.ConstraintStatus) {
+		return BasisDocument{}, fmt.Errorf(
+			"NewBasisDocument: the model has %v scalar constraints, but the basis has %v constraint statuses",
+			len(names),
+			len(basis.ConstraintStatus),
+		)
+	}
+
+	// Encode the statuses
+	doc := BasisDocument{
+		Version:     Version,
+		Variables:   map[string]string{},
+		Constraints: make([]NamedStatus, len(names)),
+	}
+	for name, id := range model.VariableIDs {
+		if status, found := basis.VariableStatus[id]; found {
+			doc.Variables[name] = status.String()
+		}
+	}
+	for ii, name := range names {
+		doc.Constraints[ii] = NamedStatus{Name: name, Status: basis.ConstraintStatus[ii].String()}
+	}
+
+	return doc, nil
+}
+
+/*
+ToBasis
+Description:
+
+	Converts the document into a basis of the model's problem.
+*/
+func (doc BasisDocument) ToBasis(model *formats.Model) (*simplex_solution.Basis, error) {
+	// Input Processing
+	if doc.Version != Version {
+		return nil, fmt.Errorf("ToBasis: unsupported version %v (expected %v)", doc.Version, Version)
+	}
+	if model == nil || model.Problem == nil {
+		return nil, fmt.Errorf("ToBasis: the model cannot be nil")
+	}
+
+	names := model.ScalarConstraintNames()
+	if len(names) != len(doc.Constraints) {
+		return nil, fmt.Errorf(
+			"ToBasis: the model has %v scalar constraints, but the document has %v constraint statuses",
+			len(names),
+			len(doc.Constraints),
+		)
+	}
+
+	// Decode the statuses
+	basis := &simplex_solution.Basis{
+		VariableStatus:   map[uint64]simplex_solution.BasisStatus{},
+		ConstraintStatus: make([]simplex_solution.BasisStatus, len(names)),
+	}
+	for name, statusName := range doc.Variables {
+		id, found := model.VariableIDs[name]
+		if !found {
+			return nil, fmt.Errorf("ToBasis: unknown variable \"%v\"", name)
+		}
+		status, err := simplex_solution.ToBasisStatus(statusName)
+		if err != nil {
+			return nil, fmt.Errorf("ToBasis: %v", err)
+		}
+		basis.VariableStatus[id] = status
+	}
+	for ii, constraint := range doc.Constraints {
+		if constraint.Name != names[ii] {
+			return nil, fmt.Errorf("ToBasis: expected the status of \"%v\", but found \"%v\"", names[ii], constraint.Name)
+		}
+		status, err := simplex_solution.ToBasisStatus(constraint.Status)
+		if err != nil {
+			return nil, fmt.Errorf("ToBasis: %v", err)
+		}
+		basis.ConstraintStatus[ii] = status
+	}
+
+	return basis, nil
+}
+
+/*
+MarshalBasis
+Description:
+
+	Encodes a basis of the model's problem as JSON (see BasisDocument).
+*/
+func MarshalBasis(basis *simplex_solution.Basis, model *formats.Model) ([]byte, error) {
+	doc, err := NewBasisDocument(basis, model)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+/*
+UnmarshalBasis
+Description:
+
+	Decodes a basis of the model's problem that was encoded with MarshalBasis.
+*/
+func UnmarshalBasis(data []byte, model *formats.Model) (*simplex_solution.Basis, error) {
+	var doc BasisDocument
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("UnmarshalBasis: %v", err)
+	}
+	return doc.ToBasis(model)
+}
@@ -0,0 +1,364 @@
+package json_format
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+)
+
+// Version is the version of the JSON encoding written by this package.
+// Documents with a different version are rejected when they are read.
+const Version = 1
+
+/*
+ProblemDocument
+Description:
+
+	The JSON encoding of a linear program. Variables and constraints are
+	referred to by name. Missing bounds (nil) are infinite and an equality
+	constraint has the same lower and upper bound, e.g.
+
+		{
+		  "version": 1,
+		  "name": "example",
+		  "objective": {"sense": "maximize", "coefficients": {"x": 3, "y": 2}},
+		  "variables": [{"name": "x", "lower": 0, "type": "continuous"}, ...],
+		  "constraints": [{"name": "c1", "coefficients": {"x": 1, "y": 1}, "upper": 4}, ...]
+		}
+*/
+type ProblemDocument struct {
+	Version     int                  `json:"version"`
+	Name        string               `json:"name,omitempty"`
+	Objective   ObjectiveDocument    `json:"objective"`
+	Variables   []VariableDocument   `json:"variables"`
+	Constraints []ConstraintDocument `json:"constraints"`
+}
+
+/*
+ObjectiveDocument
+Description:
+
+	The objective sense ("minimize" or "maximize"), the coefficient of each variable
+	(by name) and the constant of a linear objective.
+*/
+type ObjectiveDocument struct {
+	Sense        string             `json:"sense"`
+	Coefficients map[string]float64 `json:"coefficients"`
+	Constant     float64            `json:"constant,omitempty"`
+}
+
+/*
+VariableDocument
+Description:
+
+	A variable with its bounds and its type ("continuous", "integer" or "binary").
+*/
+type VariableDocument struct {
+	Name  string   `json:"name"`
+	Lower *float64 `json:"lower,omitempty"`
+	Upper *float64 `json:"upper,omitempty"`
+	Type  string   `json:"type"`
+}
+
+/*
+ConstraintDocument
+Description:
+
+	A linear constraint
+		Lower <= sum_name Coefficients[name] * name <= Upper
+*/
+type ConstraintDocument struct {
+	Name         string             `json:"name"`
+	Coefficients map[string]float64 `json:"coefficients"`
+	Lower        *float64           `json:"lower,omitempty"`
+	Upper        *float64           `json:"upper,omitempty"`
+}
+
+/*
+NewProblemDocument
+Description:
+
+	Converts the model into a ProblemDocument.
+	Constraints that are implied by the bounds of a single variable (e.g., the bound
+	constraints created by formats.Model.AddBoundConstraints) and constraints without a
+	finite bound (e.g., x + y <= +Inf), which do not restrict the problem, are omitted
+	and the two halves of a ranged constraint are combined into one constraint.
+*/
+func NewProblemDocument(model *formats.Model) (ProblemDocument, error) {
+	// Input Processing
+	if model == nil || model.Problem == nil {
+		return ProblemDocument{}, fmt.Errorf("NewProblemDocument: the model cannot be nil")
+	}
+
+	objective, objConstant, err := model.LinearObjective()
+	if err != nil {
+		return ProblemDocument{}, fmt.Errorf("NewProblemDocument: %v", err)
+	}
+
+	rows, err := model.LinearRows()
+	if err != nil {
+		return ProblemDocument{}, fmt.Errorf("NewProblemDocument: %v", err)
+	}
+
+	// Setup
+	names := model.VariableNames()
+	variables := model.Problem.Variables
+	doc := ProblemDocument{
+		Version: Version,
+		Name:    model.Problem.Name,
+		Objective: ObjectiveDocument{
+			Sense:        "minimize",
+			Coefficients: map[string]float64{},
+			Constant:     objConstant,
+		},
+		Variables:   []VariableDocument{},
+		Constraints: []ConstraintDocument{},
+	}
+
+	// Encode the objective
+	if model.Problem.Objective.Sense == problem.SenseMaximize {
+		doc.Objective.Sense = "maximize"
+	}
+	for jj, coeff := range objective {
+		if coeff != 0 {
+			doc.Objective.Coefficients[names[jj]] = coeff
+		}
+	}
+
+	// Encode the variables
+	for jj, v := range variables {
+		vtype, err := varTypeName(v.Type)
+		if err != nil {
+			return ProblemDocument{}, fmt.Errorf("NewProblemDocument: the variable \"%v\" %v", names[jj], err)
+		}
+		doc.Variables = append(doc.Variables, VariableDocument{
+			Name:  names[jj],
+			Lower: boundOrNil(v.Lower),
+			Upper: boundOrNil(v.Upper),
+			Type:  vtype,
+		})
+	}
+
+	// Encode the constraints
+	for _, row := range rows {
+		if row.IsImpliedByVariableBounds(variables) || (!row.HasLower() && !row.HasUpper()) {
+			continue
+		}
+		constraint := ConstraintDocument{
+			Name:         row.Name,
+			Coefficients: map[string]float64{},
+			Lower:        boundOrNil(row.Lower),
+			Upper:        boundOrNil(row.Upper),
+		}
+		for kk, varIdx := range row.VariableIndices {
+			constraint.Coefficients[names[varIdx]] = row.Coefficients[kk]
+		}
+		doc.Constraints = append(doc.Constraints, constraint)
+	}
+
+	return doc, nil
+}
+
+/*
+ToModel
+Description:
+
+	Converts the document into a formats.Model.
+	As with the model readers, a constraint with two different bounds becomes two
+	constraints with the same name and the bounds of the variables are made explicit
+	(see formats.Model.AddBoundConstraints).
+*/
+func (doc ProblemDocument) ToModel() (*formats.Model, error) {
+	// Input Processing
+	if doc.Version != Version {
+		return nil, fmt.Errorf("ToModel: unsupported version %v (expected %v)", doc.Version, Version)
+	}
+
+	var sense problem.ObjSense
+	switch doc.Objective.Sense {
+	case "minimize":
+		sense = problem.SenseMinimize
+	case "maximize":
+		sense = problem.SenseMaximize
+	default:
+		return nil, fmt.Errorf("ToModel: unknown objective sense \"%v\"", doc.Objective.Sense)
+	}
+
+	// Create the variables
+	model := formats.NewModel(doc.Name)
+	for _, variable := range doc.Variables {
+		vtype, err := toVarType(variable.Type)
+		if err != nil {
+			return nil, fmt.Errorf("ToModel: the variable \"%v\" %v", variable.Name, err)
+		}
+		_, err = model.AddVariable(
+			variable.Name,
+			boundOrInfinity(variable.Lower, -1.0),
+			boundOrInfinity(variable.Upper, 1.0),
+			vtype,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("ToModel: %v", err)
+		}
+	}
+
+	// Create the objective
+	objective, err := linearExpression(model, doc.Objective.Coefficients, doc.Objective.Constant)
+	if err != nil {
+		return nil, fmt.Errorf("ToModel: the objective %v", err)
+	}
+	err = model.Problem.SetObjective(objective, sense)
+	if err != nil {
+		return nil, fmt.Errorf("ToModel: %v", err)
+	}
+
+	// Create the constraints
+	for _, constraint := range doc.Constraints {
+		expr, err := linearExpression(model, constraint.Coefficients, 0.0)
+		if err != nil {
+			return nil, fmt.Errorf("ToModel: the constraint \"%v\" %v", constraint.Name, err)
+		}
+
+		switch {
+		case constraint.Lower == nil && constraint.Upper == nil:
+			return nil, fmt.Errorf("ToModel: the constraint \"%v\" has no bounds", constraint.Name)
+		case constraint.Lower != nil && constraint.Upper != nil && *constraint.Lower == *constraint.Upper:
+			model.AddConstraint(constraint.Name, expr.Eq(*constraint.Lower))
+		default:
+			if constraint.Lower != nil {
+				model.AddConstraint(constraint.Name, expr.GreaterEq(*constraint.Lower))
+			}
+			if constraint.Upper != nil {
+				model.AddConstraint(constraint.Name, expr.LessEq(*constraint.Upper))
+			}
+		}
+	}
+
+	// Make the bounds explicit
+	for _, v := range model.Problem.Variables {
+		err := model.AddBoundConstraints(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return model, nil
+}
+
+/*
+MarshalModel
+Description:
+
+	Encodes the model as JSON (see ProblemDocument).
+*/
+func MarshalModel(model *formats.Model) ([]byte, error) {
+	doc, err := NewProblemDocument(model)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+/*
+MarshalProblem
+Description:
+
+	Encodes a problem that was not read from a model file as JSON.
+	The variables and constraints are named as described in formats.ModelFrom.
+*/
+func MarshalProblem(prob *problem.OptimizationProblem) ([]byte, error) {
+	model, err := formats.ModelFrom(prob)
+	if err != nil {
+		return nil, fmt.Errorf("MarshalProblem: %v", err)
+	}
+	return MarshalModel(model)
+}
+
+/*
+UnmarshalModel
+Description:
+
+	Decodes a model that was encoded with MarshalModel (or written by hand).
+*/
+func UnmarshalModel(data []byte) (*formats.Model, error) {
+	var doc ProblemDocument
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("UnmarshalModel: %v", err)
+	}
+	return doc.ToModel()
+}
+
+/*
+linearExpression
+Description:
+
+	Creates the linear expression with the given coefficients (by variable name).
+	The terms are ordered as the variables of the model.
+*/
+func linearExpression(model *formats.Model, coefficients map[string]float64, constant float64) (symbolic.ScalarExpression, error) {
+	// Check the names
+	for name := range coefficients {
+		if _, found := model.VariableIDs[name]; !found {
+			return nil, fmt.Errorf("refers to the unknown variable \"%v\"", name)
+		}
+	}
+
+	// Collect the terms
+	var coeffs []float64
+	var vars []symbolic.Variable
+	for _, v := range model.Problem.Variables {
+		if coeff, found := coefficients[v.Name]; found && coeff != 0 {
+			coeffs = append(coeffs, coeff)
+			vars = append(vars, v)
+		}
+	}
+
+	return formats.LinearExpression(coeffs, vars, constant), nil
+}
+
+// boundOrNil returns nil for an infinite bound and a pointer to the bound otherwise.
+func boundOrNil(bound float64) *float64 {
+	if !formats.IsFinite(bound) {
+		return nil
+	}
+	return &bound
+}
+
+// boundOrInfinity returns the bound or sign * symbolic.Infinity if it is missing.
+func boundOrInfinity(bound *float64, sign float64) float64 {
+	if bound == nil {
+		return sign * symbolic.Infinity.Constant()
+	}
+	return formats.ToBound(*bound)
+}
+
+// varTypeName returns the name of a variable type in the JSON encoding.
+func varTypeName(vtype symbolic.VarType) (string, error) {
+	switch vtype {
+	case symbolic.Continuous:
+		return "continuous", nil
+	case symbolic.Integer:
+		return "integer", nil
+	case symbolic.Binary:
+		return "binary", nil
+	}
+	return "", fmt.Errorf("has an unsupported type (%v)", vtype)
+}
+
+// toVarType converts the name of a variable type back into a symbolic.VarType.
+// An empty name is treated as "continuous".
+func toVarType(name string) (symbolic.VarType, error) {
+	switch name {
+	case "continuous", "":
+		return symbolic.Continuous, nil
+	case "integer":
+		return symbolic.Integer, nil
+	case "binary":
+		return symbolic.Binary, nil
+	}
+	return symbolic.Continuous, fmt.Errorf("has an unknown type \"%v\"", name)
+}
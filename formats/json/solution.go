@@ -0,0 +1,230 @@
+package json_format
+
+import (
+	"encoding/json"
+	"fmt"
+
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/simplex/formats"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+)
+
+// statusNames contains the name of each solution status in the JSON encoding.
+var statusNames = map[solution_status.SolutionStatus]string{
+	solution_status.LOADED:          "LOADED",
+	solution_status.OPTIMAL:         "OPTIMAL",
+	solution_status.INFEASIBLE:      "INFEASIBLE",
+	solution_status.INF_OR_UNBD:     "INF_OR_UNBD",
+	solution_status.UNBOUNDED:       "UNBOUNDED",
+	solution_status.CUTOFF:          "CUTOFF",
+	solution_status.ITERATION_LIMIT: "ITERATION_LIMIT",
+	solution_status.NODE_LIMIT:      "NODE_LIMIT",
+	solution_status.TIME_LIMIT:      "TIME_LIMIT",
+	solution_status.SOLUTION_LIMIT:  "SOLUTION_LIMIT",
+	solution_status.INTERRUPTED:     "INTERRUPTED",
+	solution_status.NUMERIC:         "NUMERIC",
+	solution_status.SUBOPTIMAL:      "SUBOPTIMAL",
+	solution_status.INPROGRESS:      "INPROGRESS",
+	solution_status.USER_OBJ_LIMIT:  "USER_OBJ_LIMIT",
+	solution_status.WORK_LIMIT:      "WORK_LIMIT",
+}
+
+/*
+NamedValue
+Description:
+
+	A value that belongs to a named constraint.
+*/
+type NamedValue struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+}
+
+/*
+SolutionDocument
+Description:
+
+	The JSON encoding of a simplex_solution.SimplexSolution.
+	Variables are referred to by name. The duals are listed in the order of the
+	model's scalar constraints (see formats.Model.ScalarConstraintNames) because
+	the two halves of a ranged constraint share the same name.
+	The objective is only written; it is recomputed from the values when a solution is read.
+*/
+type SolutionDocument struct {
+	Version      int                `json:"version"`
+	Status       string             `json:"status"`
+	Objective    float64            `json:"objective"`
+	Iterations   int                `json:"iterations"`
+	Variables    map[string]float64 `json:"variables"`
+	Duals        []NamedValue       `json:"duals,omitempty"`
+	ReducedCosts map[string]float64 `json:"reduced_costs,omitempty"`
+}
+
+/*
+NewSolutionDocument
+Description:
+
+	Converts the solution of the model's problem into a SolutionDocument.
+*/
+func NewSolutionDocument(sol *simplex_solution.SimplexSolution, model *formats.Model) (SolutionDocument, error) {
+	// Input Processing
+	if sol == nil {
+		return SolutionDocument{}, fmt.Errorf("NewSolutionDocument: the solution cannot be nil")
+	}
+	if model == nil || model.Problem == nil {
+		return SolutionDocument{}, fmt.Errorf("NewSolutionDocument: the model cannot be nil")
+	}
+
+	status, found := statusNames[sol.Status]
+	if !found {
+		return SolutionDocument{}, fmt.Errorf("NewSolutionDocument: unknown solution status %v", sol.Status)
+	}
+
+	// Encode the solution
+	doc := SolutionDocument{
+		Version:    Version,
+		Status:     status,
+		Objective:  sol.Objective,
+		Iterations: sol.Iterations,
+		Variables:  model.ValuesByName(sol.VariableValues),
+	}
+
+	if sol.DualValues != nil {
+		names := model.ScalarConstraintNames()
+		if len(names) != len(sol.DualValues) {
+			return SolutionDocument{}, fmt.Errorf(
+				"NewSolutionDocument: the model has %v scalar constraints, but the solution has %v dual values",
+				len(names),
+				len(sol.DualValues),
+			)
+		}
+		doc.Duals = make([]NamedValue, len(names))
+		for ii, name := range names {
+			doc.Duals[ii] = NamedValue{Name: name, Value: sol.DualValues[ii]}
+		}
+	}
+
+	if sol.ReducedCosts != nil {
+		doc.ReducedCosts = model.ValuesByName(sol.ReducedCosts)
+	}
+
+	return doc, nil
+}
+
+/*
+ToSolution
+Description:
+
+	Converts the document into a solution of the model's problem.
+*/
+func (doc SolutionDocument) ToSolution(model *formats.Model) (*simplex_solution.SimplexSolution, error) {
+	// Input Processing
+	if doc.Version != Version {
+		return nil, fmt.Errorf("ToSolution: unsupported version %v (expected %v)", doc.Version, Version)
+	}
+	if model == nil || model.Problem == nil {
+		return nil, fmt.Errorf("ToSolution: the model cannot be nil")
+	}
+
+	// Setup
+	sol := &simplex_solution.SimplexSolution{
+		Iterations:      doc.Iterations,
+		OriginalProblem: model.Problem,
+	}
+
+	status, err := toStatus(doc.Status)
+	if err != nil {
+		return nil, fmt.Errorf("ToSolution: %v", err)
+	}
+	sol.Status = status
+
+	// Decode the values
+	sol.VariableValues, err = valuesByID(model, doc.Variables)
+	if err != nil {
+		return nil, fmt.Errorf("ToSolution: %v", err)
+	}
+
+	err = sol.EvaluateAtVariableValues()
+	if err != nil {
+		return nil, fmt.Errorf("ToSolution: %v", err)
+	}
+
+	if doc.ReducedCosts != nil {
+		sol.ReducedCosts, err = valuesByID(model, doc.ReducedCosts)
+		if err != nil {
+			return nil, fmt.Errorf("ToSolution: %v", err)
+		}
+	}
+
+	if doc.Duals != nil {
+		names := model.ScalarConstraintNames()
+		if len(names) != len(doc.Duals) {
+			return nil, fmt.Errorf(
+				"ToSolution: the model has %v scalar constraints, but the document has %v dual values",
+				len(names),
+				len(doc.Duals),
+			)
+		}
+		sol.DualValues = make([]float64, len(names))
+		for ii, dual := range doc.Duals {
+			if dual.Name != names[ii] {
+				return nil, fmt.Errorf("ToSolution: expected the dual value of \"%v\", but found \"%v\"", names[ii], dual.Name)
+			}
+			sol.DualValues[ii] = dual.Value
+		}
+	}
+
+	return sol, nil
+}
+
+/*
+MarshalSolution
+Description:
+
+	Encodes the solution of the model's problem as JSON (see SolutionDocument).
+*/
+func MarshalSolution(sol *simplex_solution.SimplexSolution, model *formats.Model) ([]byte, error) {
+	doc, err := NewSolutionDocument(sol, model)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+/*
+UnmarshalSolution
+Description:
+
+	Decodes a solution of the model's problem that was encoded with MarshalSolution.
+*/
+func UnmarshalSolution(data []byte, model *formats.Model) (*simplex_solution.SimplexSolution, error) {
+	var doc SolutionDocument
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("UnmarshalSolution: %v", err)
+	}
+	return doc.ToSolution(model)
+}
+
+// toStatus converts the name of a solution status back into a SolutionStatus.
+func toStatus(name string) (solution_status.SolutionStatus, error) {
+	for status, statusName := range statusNames {
+		if statusName == name {
+			return status, nil
+		}
+	}
+	return solution_status.LOADED, fmt.Errorf("unknown solution status \"%v\"", name)
+}
+
+// valuesByID translates a map from variable names to values into a map from variable IDs to values.
+func valuesByID(model *formats.Model, values map[string]float64) (map[uint64]float64, error) {
+	out := map[uint64]float64{}
+	for name, value := range values {
+		id, found := model.VariableIDs[name]
+		if !found {
+			return nil, fmt.Errorf("unknown variable \"%v\"", name)
+		}
+		out[id] = value
+	}
+	return out, nil
+}
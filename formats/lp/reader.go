@@ -0,0 +1,728 @@
+package lp_format
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+)
+
+/*
+ParseError
+Description:
+
+	Describes a problem found on a specific line of an LP file.
+*/
+type ParseError struct {
+	Line    int
+	Message string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("LP parse error on line %v: %v", e.Line, e.Message)
+}
+
+// The sections of an LP file.
+type section int
+
+const (
+	sectionNone section = iota
+	sectionObjective
+	sectionConstraints
+	sectionBounds
+	sectionGenerals
+	sectionBinaries
+	sectionEnd
+)
+
+// sectionKeywords maps each (lower case) section keyword to its section.
+// Keywords with two words are matched after collapsing the whitespace between them.
+var sectionKeywords = map[string]section{
+	"minimize":   sectionObjective,
+	"minimise":   sectionObjective,
+	"minimum":    sectionObjective,
+	"min":        sectionObjective,
+	"maximize":   sectionObjective,
+	"maximise":   sectionObjective,
+	"maximum":    sectionObjective,
+	"max":        sectionObjective,
+	"subject to": sectionConstraints,
+	"such that":  sectionConstraints,
+	"st":         sectionConstraints,
+	"s.t.":       sectionConstraints,
+	"st.":        sectionConstraints,
+	"bounds":     sectionBounds,
+	"bound":      sectionBounds,
+	"general":    sectionGenerals,
+	"generals":   sectionGenerals,
+	"gen":        sectionGenerals,
+	"integer":    sectionGenerals,
+	"integers":   sectionGenerals,
+	"binary":     sectionBinaries,
+	"binaries":   sectionBinaries,
+	"bin":        sectionBinaries,
+	"end":        sectionEnd,
+}
+
+// lpVariable collects the information about one variable.
+type lpVariable struct {
+	name  string
+	lower float64
+	upper float64
+	vtype symbolic.VarType
+}
+
+// lpExpression is a linear expression sum_k coeffs[k] * x_{varIdxs[k]} + constant.
+type lpExpression struct {
+	varIdxs  []int
+	coeffs   []float64
+	constant float64
+}
+
+// lpConstraint is the constraint lower <= expr <= upper (missing limits are +/- symbolic.Infinity).
+type lpConstraint struct {
+	name  string
+	expr  lpExpression
+	lower float64
+	upper float64
+}
+
+// lpReader holds the state of the parser.
+type lpReader struct {
+	tokens []token
+	pos    int
+
+	name        string
+	sense       problem.ObjSense
+	objective   lpExpression
+	constraints []lpConstraint
+	variables   []*lpVariable
+	varIndex    map[string]int
+}
+
+/*
+ReadFile
+Description:
+
+	Opens the given file and reads it with Read.
+*/
+func ReadFile(filename string) (*formats.Model, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Read(file)
+}
+
+/*
+Read
+Description:
+
+	Parses a model in the CPLEX LP format and converts it into a formats.Model.
+	The objective (Minimize/Maximize), Subject To, Bounds, General and Binary
+	sections are supported and the file must finish with End. Comments start with
+	a backslash and the comment "\Problem name: <name>" names the problem.
+	Constraints have the form
+		[name:] expression sense constant		or
+		[name:] constant <= expression <= constant
+	and unnamed constraints are named "R1", "R2", ... . Variables are nonnegative
+	unless the Bounds section says otherwise. As for MPS files, the bounds are also
+	added as explicit constraints (see formats.Model.AddBoundConstraints) and a
+	ranged constraint becomes two constraints with the same name.
+*/
+func Read(r io.Reader) (*formats.Model, error) {
+	// Setup
+	reader := &lpReader{
+		sense:    problem.SenseMinimize,
+		varIndex: map[string]int{},
+	}
+
+	// Split the input into sections and tokenize each section
+	sectionTokens := map[section][]token{}
+	sectionsSeen := []section{}
+	current := sectionNone
+	ended := false
+	lineNumber := 0
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	for scanner.Scan() && !ended {
+		lineNumber++
+		line, comment, _ := strings.Cut(scanner.Text(), `\`)
+
+		// The name of the problem is stored in a comment
+		if name, found := strings.CutPrefix(strings.TrimSpace(comment), "Problem name:"); found && reader.name == "" {
+			reader.name = strings.TrimSpace(name)
+		}
+
+		// Detect the section keywords at the start of a line
+		if next, rest, found := matchSectionKeyword(line); found {
+			if next == sectionObjective {
+				reader.sense = problem.SenseMinimize
+				if strings.HasPrefix(strings.ToLower(strings.TrimSpace(line)), "max") {
+					reader.sense = problem.SenseMaximize
+				}
+			}
+			for _, seen := range sectionsSeen {
+				if seen == next && next != sectionEnd {
+					return nil, ParseError{lineNumber, "the section appears more than once"}
+				}
+			}
+			sectionsSeen = append(sectionsSeen, next)
+			current, line = next, rest
+			ended = current == sectionEnd
+		}
+
+		tokens, err := tokenize(line, lineNumber)
+		if err != nil {
+			return nil, err
+		}
+		if len(tokens) > 0 && (current == sectionNone || current == sectionEnd) {
+			return nil, ParseError{lineNumber, "text outside of a section"}
+		}
+		sectionTokens[current] = append(sectionTokens[current], tokens...)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if !ended {
+		return nil, ParseError{lineNumber, "missing End"}
+	}
+
+	// Parse the sections in order
+	parsers := []struct {
+		section section
+		parse   func() error
+	}{
+		{sectionObjective, reader.parseObjective},
+		{sectionConstraints, reader.parseConstraints},
+		{sectionBounds, reader.parseBounds},
+		{sectionGenerals, func() error { return reader.parseVariableTypes(symbolic.Integer) }},
+		{sectionBinaries, func() error { return reader.parseVariableTypes(symbolic.Binary) }},
+	}
+	for _, parser := range parsers {
+		reader.tokens, reader.pos = sectionTokens[parser.section], 0
+		if err := parser.parse(); err != nil {
+			return nil, err
+		}
+	}
+
+	return reader.toModel()
+}
+
+/*
+matchSectionKeyword
+Description:
+
+	Checks if the line starts with a section keyword. If it does, then the section
+	and the rest of the line (after the keyword) are returned.
+*/
+func matchSectionKeyword(line string) (section, string, bool) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return sectionNone, "", false
+	}
+
+	for nWords := 2; nWords >= 1; nWords-- {
+		if len(fields) < nWords {
+			continue
+		}
+		keyword := strings.ToLower(strings.Join(fields[:nWords], " "))
+		next, found := sectionKeywords[keyword]
+		if !found {
+			continue
+		}
+
+		// Remove the keyword from the line
+		rest := strings.TrimSpace(line)
+		for _, field := range fields[:nWords] {
+			rest = strings.TrimSpace(rest[len(field):])
+		}
+		return next, rest, true
+	}
+
+	return sectionNone, "", false
+}
+
+/*
+parseObjective
+Description:
+
+	Parses the (optional) name and the expression of the objective.
+*/
+func (reader *lpReader) parseObjective() error {
+	reader.skipLabel()
+	if reader.done() {
+		return nil
+	}
+
+	expr, err := reader.parseExpression()
+	if err != nil {
+		return err
+	}
+	if !reader.done() {
+		return reader.errorf("unexpected \"%v\" in the objective", reader.peek().text)
+	}
+
+	reader.objective = expr
+	return nil
+}
+
+/*
+parseConstraints
+Description:
+
+	Parses the constraints of the Subject To section.
+*/
+func (reader *lpReader) parseConstraints() error {
+	for !reader.done() {
+		// Name the constraint
+		name := reader.skipLabel()
+		if name == "" {
+			name = fmt.Sprintf("R%v", len(reader.constraints)+1)
+		}
+
+		// Ranged constraints start with a constant
+		if lower, ok := reader.peekNumberBeforeSense(); ok {
+			firstSense := reader.next()
+			expr, err := reader.parseExpression()
+			if err != nil {
+				return err
+			}
+			secondSense := reader.next()
+			upper, err := reader.parseNumber()
+			if err != nil {
+				return err
+			}
+			if firstSense.kind != tokenSense || secondSense.kind != tokenSense || firstSense.text != secondSense.text || firstSense.text == "=" {
+				return ParseError{firstSense.line, fmt.Sprintf("the ranged constraint \"%v\" must use the same inequality twice", name)}
+			}
+			if firstSense.text == ">=" {
+				lower, upper = upper, lower
+			}
+
+			expr.constant, lower, upper = 0.0, lower-expr.constant, upper-expr.constant
+			reader.constraints = append(reader.constraints, lpConstraint{name, expr, lower, upper})
+			continue
+		}
+
+		// All other constraints have the form expression sense constant
+		expr, err := reader.parseExpression()
+		if err != nil {
+			return err
+		}
+		senseToken := reader.next()
+		if senseToken.kind != tokenSense {
+			return ParseError{senseToken.line, fmt.Sprintf("expected an inequality or equality in the constraint \"%v\", but got \"%v\"", name, senseToken.text)}
+		}
+		rhs, err := reader.parseNumber()
+		if err != nil {
+			return err
+		}
+
+		constraint := lpConstraint{
+			name:  name,
+			expr:  expr,
+			lower: -symbolic.Infinity.Constant(),
+			upper: symbolic.Infinity.Constant(),
+		}
+		applyLimit(&constraint.lower, &constraint.upper, senseToken.text, rhs-expr.constant)
+		constraint.expr.constant = 0.0
+		reader.constraints = append(reader.constraints, constraint)
+	}
+
+	return nil
+}
+
+/*
+parseBounds
+Description:
+
+	Parses the statements of the Bounds section:
+		x free,  x sense constant,  constant sense x  and  constant sense x sense constant.
+*/
+func (reader *lpReader) parseBounds() error {
+	for !reader.done() {
+		// Bounds that start with a constant
+		var leftValue float64
+		var leftSense token
+		if value, ok := reader.peekNumberBeforeSense(); ok {
+			leftValue, leftSense = value, reader.next()
+		}
+
+		nameToken := reader.next()
+		if nameToken.kind != tokenIdentifier {
+			return ParseError{nameToken.line, fmt.Sprintf("expected a variable name, but got \"%v\"", nameToken.text)}
+		}
+		v := reader.variables[reader.variableIndex(nameToken.text)]
+
+		if leftSense.kind == tokenSense {
+			applyLimit(&v.lower, &v.upper, reverseSense(leftSense.text), leftValue)
+		}
+
+		// Bounds after the variable
+		switch next := reader.peek(); {
+		case next.kind == tokenIdentifier && strings.EqualFold(next.text, "free"):
+			reader.next()
+			if leftSense.kind == tokenSense {
+				return ParseError{next.line, fmt.Sprintf("the variable \"%v\" cannot be both bounded and free", v.name)}
+			}
+			v.lower, v.upper = -symbolic.Infinity.Constant(), symbolic.Infinity.Constant()
+		case next.kind == tokenSense:
+			reader.next()
+			value, err := reader.parseNumber()
+			if err != nil {
+				return err
+			}
+			applyLimit(&v.lower, &v.upper, next.text, value)
+		case leftSense.kind != tokenSense:
+			return ParseError{nameToken.line, fmt.Sprintf("the bound on \"%v\" is missing an inequality", v.name)}
+		}
+	}
+
+	return nil
+}
+
+/*
+parseVariableTypes
+Description:
+
+	Parses the list of variable names in a General or Binary section.
+	Binary variables are bounded between 0 and 1.
+*/
+func (reader *lpReader) parseVariableTypes(vtype symbolic.VarType) error {
+	for !reader.done() {
+		nameToken := reader.next()
+		if nameToken.kind != tokenIdentifier {
+			return ParseError{nameToken.line, fmt.Sprintf("expected a variable name, but got \"%v\"", nameToken.text)}
+		}
+
+		v := reader.variables[reader.variableIndex(nameToken.text)]
+		v.vtype = vtype
+		if vtype == symbolic.Binary {
+			v.lower, v.upper = 0.0, 1.0
+		}
+	}
+	return nil
+}
+
+/*
+parseExpression
+Description:
+
+	Parses a linear expression: a sequence of terms (coefficient times variable or
+	constant) separated by + or -.
+*/
+func (reader *lpReader) parseExpression() (lpExpression, error) {
+	// Setup
+	expr := lpExpression{}
+	coeffByVar := map[int]int{}
+
+	for {
+		// Collect the signs of the term
+		sign := 1.0
+		for reader.peek().kind == tokenOperator {
+			if reader.next().text == "-" {
+				sign = -sign
+			}
+		}
+
+		// Parse the term
+		next := reader.next()
+		switch {
+		case next.kind == tokenNumber && reader.peek().kind == tokenIdentifier && !reader.peekIsLabel():
+			value, err := strconv.ParseFloat(next.text, 64)
+			if err != nil {
+				return expr, ParseError{next.line, fmt.Sprintf("invalid number \"%v\"", next.text)}
+			}
+			expr.addTerm(reader.variableIndex(reader.next().text), sign*value, coeffByVar)
+		case next.kind == tokenNumber:
+			value, err := strconv.ParseFloat(next.text, 64)
+			if err != nil {
+				return expr, ParseError{next.line, fmt.Sprintf("invalid number \"%v\"", next.text)}
+			}
+			expr.constant += sign * value
+		case next.kind == tokenIdentifier:
+			expr.addTerm(reader.variableIndex(next.text), sign, coeffByVar)
+		case next.kind == tokenEOF:
+			return expr, ParseError{next.line, "unexpected end of section in an expression"}
+		default:
+			return expr, ParseError{next.line, fmt.Sprintf("unexpected \"%v\" in an expression", next.text)}
+		}
+
+		if reader.peek().kind != tokenOperator {
+			break
+		}
+	}
+
+	return expr, nil
+}
+
+/*
+addTerm
+Description:
+
+	Adds coeff * x_{varIdx} to the expression (combining repeated variables).
+*/
+func (expr *lpExpression) addTerm(varIdx int, coeff float64, coeffByVar map[int]int) {
+	if kk, found := coeffByVar[varIdx]; found {
+		expr.coeffs[kk] += coeff
+		return
+	}
+	coeffByVar[varIdx] = len(expr.varIdxs)
+	expr.varIdxs = append(expr.varIdxs, varIdx)
+	expr.coeffs = append(expr.coeffs, coeff)
+}
+
+/*
+parseNumber
+Description:
+
+	Parses a (signed) constant, which may be +/- inf or +/- infinity.
+*/
+func (reader *lpReader) parseNumber() (float64, error) {
+	value, nTokens, ok := reader.numberAt(reader.pos)
+	if !ok {
+		next := reader.peek()
+		return 0.0, ParseError{next.line, fmt.Sprintf("expected a number, but got \"%v\"", next.text)}
+	}
+	reader.pos += nTokens
+	return value, nil
+}
+
+/*
+numberAt
+Description:
+
+	Tries to read a signed constant starting at the token with index pos.
+	Returns the value and the number of tokens it spans.
+*/
+func (reader *lpReader) numberAt(pos int) (float64, int, bool) {
+	// Setup
+	sign := 1.0
+	nTokens := 0
+	for pos+nTokens < len(reader.tokens) && reader.tokens[pos+nTokens].kind == tokenOperator {
+		if reader.tokens[pos+nTokens].text == "-" {
+			sign = -sign
+		}
+		nTokens++
+	}
+	if pos+nTokens >= len(reader.tokens) {
+		return 0.0, 0, false
+	}
+
+	// Read the number
+	tok := reader.tokens[pos+nTokens]
+	switch {
+	case tok.kind == tokenNumber:
+		value, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return 0.0, 0, false
+		}
+		return formats.ToBound(sign * value), nTokens + 1, true
+	case tok.kind == tokenIdentifier && (strings.EqualFold(tok.text, "inf") || strings.EqualFold(tok.text, "infinity")):
+		return sign * symbolic.Infinity.Constant(), nTokens + 1, true
+	}
+
+	return 0.0, 0, false
+}
+
+/*
+peekNumberBeforeSense
+Description:
+
+	Checks if the next tokens are a constant followed by an inequality or equality
+	(e.g., the "2 <=" of "2 <= x <= 3"). If they are, then the constant is consumed
+	(but not the sense).
+*/
+func (reader *lpReader) peekNumberBeforeSense() (float64, bool) {
+	value, nTokens, ok := reader.numberAt(reader.pos)
+	if !ok || reader.pos+nTokens >= len(reader.tokens) || reader.tokens[reader.pos+nTokens].kind != tokenSense {
+		return 0.0, false
+	}
+	reader.pos += nTokens
+	return value, true
+}
+
+/*
+skipLabel
+Description:
+
+	Consumes a "name:" label (if there is one) and returns the name.
+*/
+func (reader *lpReader) skipLabel() string {
+	if !reader.peekIsLabel() {
+		return ""
+	}
+	name := reader.next().text
+	reader.next()
+	return name
+}
+
+// peekIsLabel returns true if the next tokens are an identifier followed by a colon.
+func (reader *lpReader) peekIsLabel() bool {
+	return reader.peek().kind == tokenIdentifier &&
+		reader.pos+1 < len(reader.tokens) &&
+		reader.tokens[reader.pos+1].kind == tokenColon
+}
+
+// peek returns the next token without consuming it.
+func (reader *lpReader) peek() token {
+	if reader.done() {
+		line := 0
+		if len(reader.tokens) > 0 {
+			line = reader.tokens[len(reader.tokens)-1].line
+		}
+		return token{kind: tokenEOF, text: "end of section", line: line}
+	}
+	return reader.tokens[reader.pos]
+}
+
+// next consumes and returns the next token.
+func (reader *lpReader) next() token {
+	out := reader.peek()
+	if !reader.done() {
+		reader.pos++
+	}
+	return out
+}
+
+// done returns true if all of the tokens of the current section have been consumed.
+func (reader *lpReader) done() bool {
+	return reader.pos >= len(reader.tokens)
+}
+
+// errorf creates a ParseError on the line of the next token.
+func (reader *lpReader) errorf(format string, args ...any) error {
+	return ParseError{reader.peek().line, fmt.Sprintf(format, args...)}
+}
+
+/*
+variableIndex
+Description:
+
+	Returns the index of the variable with the given name, creating a
+	nonnegative continuous variable if it has not been seen before.
+*/
+func (reader *lpReader) variableIndex(name string) int {
+	if idx, found := reader.varIndex[name]; found {
+		return idx
+	}
+
+	reader.varIndex[name] = len(reader.variables)
+	reader.variables = append(reader.variables, &lpVariable{
+		name:  name,
+		lower: 0.0,
+		upper: symbolic.Infinity.Constant(),
+		vtype: symbolic.Continuous,
+	})
+	return len(reader.variables) - 1
+}
+
+/*
+applyLimit
+Description:
+
+	Applies the limit "expression sense value" to the lower and upper limits
+	of a variable or constraint.
+*/
+func applyLimit(lower *float64, upper *float64, sense string, value float64) {
+	switch sense {
+	case "<=":
+		*upper = value
+	case ">=":
+		*lower = value
+	case "=":
+		*lower, *upper = value, value
+	}
+}
+
+// reverseSense converts the sense of "value sense x" into the sense of "x sense value".
+func reverseSense(sense string) string {
+	switch sense {
+	case "<=":
+		return ">="
+	case ">=":
+		return "<="
+	}
+	return sense
+}
+
+/*
+toModel
+Description:
+
+	Converts the parsed objective, constraints and variables into a formats.Model.
+*/
+func (reader *lpReader) toModel() (*formats.Model, error) {
+	// Setup
+	model := formats.NewModel(reader.name)
+
+	// Create the variables
+	variables := make([]symbolic.Variable, len(reader.variables))
+	for ii, v := range reader.variables {
+		newVariable, err := model.AddVariable(v.name, v.lower, v.upper, v.vtype)
+		if err != nil {
+			return nil, err
+		}
+		variables[ii] = newVariable
+	}
+
+	// Create the objective
+	err := model.Problem.SetObjective(reader.expression(reader.objective, variables), reader.sense)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the constraints
+	for _, constraint := range reader.constraints {
+		expr := reader.expression(constraint.expr, variables)
+		hasLower, hasUpper := formats.IsFinite(constraint.lower), formats.IsFinite(constraint.upper)
+		switch {
+		case constraint.lower == constraint.upper:
+			model.AddConstraint(constraint.name, expr.Eq(constraint.lower))
+		case hasLower && hasUpper:
+			// Ranged constraints become a pair of constraints: lower <= expr <= upper
+			model.AddConstraint(constraint.name, expr.GreaterEq(constraint.lower))
+			model.AddConstraint(constraint.name, expr.LessEq(constraint.upper))
+		case hasLower:
+			model.AddConstraint(constraint.name, expr.GreaterEq(constraint.lower))
+		case hasUpper:
+			model.AddConstraint(constraint.name, expr.LessEq(constraint.upper))
+		}
+	}
+
+	// Make the bounds explicit
+	for _, v := range variables {
+		err := model.AddBoundConstraints(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return model, nil
+}
+
+/*
+expression
+Description:
+
+	Creates the symbolic expression of a parsed linear expression.
+*/
+func (reader *lpReader) expression(expr lpExpression, variables []symbolic.Variable) symbolic.ScalarExpression {
+	exprVariables := make([]symbolic.Variable, len(expr.varIdxs))
+	for ii, varIdx := range expr.varIdxs {
+		exprVariables[ii] = variables[varIdx]
+	}
+	return formats.LinearExpression(expr.coeffs, exprVariables, expr.constant)
+}
@@ -0,0 +1,127 @@
+package lp_format
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The kinds of tokens in an LP file.
+type tokenKind int
+
+const (
+	tokenEOF        tokenKind = iota
+	tokenNumber               // e.g., 3, 2.5, 1e-3
+	tokenIdentifier           // a variable or constraint name (or inf, free)
+	tokenOperator             // + or -
+	tokenSense                // <=, >= or = (normalized)
+	tokenColon                // :
+)
+
+// token is a single token with the line it was found on.
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+// nameDelimiters contains the characters (other than whitespace) that end a name.
+const nameDelimiters = "+-<>=:[]*^/\\"
+
+/*
+tokenize
+Description:
+
+	Splits one line (without its comment) into tokens.
+	The senses <, =<, > and => are normalized to <= and >=.
+*/
+func tokenize(line string, lineNumber int) ([]token, error) {
+	// Setup
+	var out []token
+
+	for ii := 0; ii < len(line); {
+		ch := line[ii]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\r':
+			ii++
+		case ch == '+' || ch == '-':
+			out = append(out, token{tokenOperator, string(ch), lineNumber})
+			ii++
+		case ch == ':':
+			out = append(out, token{tokenColon, ":", lineNumber})
+			ii++
+		case ch == '<' || ch == '>' || ch == '=':
+			// Read the whole operator (e.g., "<=" or "=<")
+			start := ii
+			for ii < len(line) && strings.IndexByte("<>=", line[ii]) != -1 {
+				ii++
+			}
+			sense, err := normalizeSense(line[start:ii])
+			if err != nil {
+				return nil, ParseError{lineNumber, err.Error()}
+			}
+			out = append(out, token{tokenSense, sense, lineNumber})
+		case isDigit(ch) || (ch == '.' && ii+1 < len(line) && isDigit(line[ii+1])):
+			start := ii
+			ii = scanNumber(line, ii)
+			out = append(out, token{tokenNumber, line[start:ii], lineNumber})
+		case strings.IndexByte(nameDelimiters, ch) != -1:
+			return nil, ParseError{lineNumber, fmt.Sprintf("unsupported character '%c' (only linear models are supported)", ch)}
+		default:
+			start := ii
+			for ii < len(line) && strings.IndexByte(" \t\r"+nameDelimiters, line[ii]) == -1 {
+				ii++
+			}
+			out = append(out, token{tokenIdentifier, line[start:ii], lineNumber})
+		}
+	}
+
+	return out, nil
+}
+
+/*
+scanNumber
+Description:
+
+	Returns the index just after the number that starts at index start
+	(digits, an optional decimal point and an optional exponent).
+*/
+func scanNumber(line string, start int) int {
+	ii := start
+	for ii < len(line) && (isDigit(line[ii]) || line[ii] == '.') {
+		ii++
+	}
+
+	// Exponent
+	if ii < len(line) && (line[ii] == 'e' || line[ii] == 'E') {
+		jj := ii + 1
+		if jj < len(line) && (line[jj] == '+' || line[jj] == '-') {
+			jj++
+		}
+		if jj < len(line) && isDigit(line[jj]) {
+			for jj < len(line) && isDigit(line[jj]) {
+				jj++
+			}
+			ii = jj
+		}
+	}
+
+	return ii
+}
+
+// isDigit returns true if the character is a decimal digit.
+func isDigit(ch byte) bool {
+	return '0' <= ch && ch <= '9'
+}
+
+// normalizeSense converts the different spellings of a sense into <=, >= or =.
+func normalizeSense(sense string) (string, error) {
+	switch sense {
+	case "<", "<=", "=<":
+		return "<=", nil
+	case ">", ">=", "=>":
+		return ">=", nil
+	case "=":
+		return "=", nil
+	}
+	return "", fmt.Errorf("unknown operator \"%v\"", sense)
+}
@@ -0,0 +1,305 @@
+package lp_format
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+)
+
+// maxLineLength is the length after which the terms of an expression are continued on a new line.
+const maxLineLength = 255
+
+/*
+WriteFile
+Description:
+
+	Writes the model to the file with the given name (see Write).
+*/
+func WriteFile(filename string, model *formats.Model) error {
+	// Create the file
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	err = Write(file, model)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+/*
+Write
+Description:
+
+	Writes the model in the CPLEX LP format.
+	Constraints that are implied by the bounds of a single variable (e.g., the bound
+	constraints created by the reader) are omitted, the two halves of a ranged
+	constraint are written as "name: lower <= expression <= upper" and the bounds
+	of the variables are written in the Bounds section, so that Read(Write(model))
+	describes the same problem as model.
+*/
+func Write(w io.Writer, model *formats.Model) error {
+	// Input Processing
+	if model == nil || model.Problem == nil {
+		return fmt.Errorf("Write: the model cannot be nil")
+	}
+
+	objective, objConstant, err := model.LinearObjective()
+	if err != nil {
+		return fmt.Errorf("Write: %v", err)
+	}
+
+	allRows, err := model.LinearRows()
+	if err != nil {
+		return fmt.Errorf("Write: %v", err)
+	}
+
+	// Select the rows to write
+	variables := model.Problem.Variables
+	rows := []formats.LinearRow{}
+	for _, row := range allRows {
+		if row.IsImpliedByVariableBounds(variables) || (!row.HasLower() && !row.HasUpper()) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	// Check the names
+	names := model.VariableNames()
+	if err := checkNames("variable", names); err != nil {
+		return err
+	}
+
+	rowNames := make([]string, len(rows))
+	for ii, row := range rows {
+		rowNames[ii] = row.Name
+	}
+	if err := checkNames("constraint", rowNames); err != nil {
+		return err
+	}
+
+	// Write the objective
+	out := bufio.NewWriter(w)
+	if model.Problem.Name != "" {
+		fmt.Fprintf(out, "\\Problem name: %v\n\n", model.Problem.Name)
+	}
+
+	if model.Problem.Objective.Sense == problem.SenseMaximize {
+		out.WriteString("Maximize\n")
+	} else {
+		out.WriteString("Minimize\n")
+	}
+
+	objIdxs := []int{}
+	objCoeffs := []float64{}
+	for jj, coeff := range objective {
+		if coeff != 0 {
+			objIdxs = append(objIdxs, jj)
+			objCoeffs = append(objCoeffs, coeff)
+		}
+	}
+	out.WriteString(formatExpression(" obj: ", objIdxs, objCoeffs, objConstant, names) + "\n")
+
+	// Write the constraints
+	out.WriteString("Subject To\n")
+	for _, row := range rows {
+		idxs, coeffs := row.VariableIndices, row.Coefficients
+		if len(idxs) == 0 && len(names) > 0 {
+			// The format cannot express constraints without variables
+			idxs, coeffs = []int{0}, []float64{0.0}
+		}
+
+		label := fmt.Sprintf(" %v: ", row.Name)
+		switch {
+		case row.IsRanged():
+			label += formatNumber(row.Lower) + " <= "
+			out.WriteString(formatExpression(label, idxs, coeffs, 0.0, names) + " <= " + formatNumber(row.Upper))
+		case row.HasLower() && row.HasUpper():
+			out.WriteString(formatExpression(label, idxs, coeffs, 0.0, names) + " = " + formatNumber(row.Lower))
+		case row.HasLower():
+			out.WriteString(formatExpression(label, idxs, coeffs, 0.0, names) + " >= " + formatNumber(row.Lower))
+		default:
+			out.WriteString(formatExpression(label, idxs, coeffs, 0.0, names) + " <= " + formatNumber(row.Upper))
+		}
+		out.WriteString("\n")
+	}
+
+	// Write the bounds
+	out.WriteString("Bounds\n")
+	for jj, v := range variables {
+		if bound := formatBound(names[jj], v); bound != "" {
+			out.WriteString(" " + bound + "\n")
+		}
+	}
+
+	// Write the integer and binary variables
+	for _, vtype := range []symbolic.VarType{symbolic.Integer, symbolic.Binary} {
+		header := "Generals\n"
+		if vtype == symbolic.Binary {
+			header = "Binaries\n"
+		}
+		for jj, v := range variables {
+			if v.Type != vtype {
+				continue
+			}
+			out.WriteString(header + " " + names[jj] + "\n")
+			header = ""
+		}
+	}
+
+	out.WriteString("End\n")
+
+	return out.Flush()
+}
+
+/*
+WriteProblem
+Description:
+
+	Writes a problem that was not read from a model file.
+	The variables and constraints are named as described in formats.ModelFrom.
+*/
+func WriteProblem(w io.Writer, prob *problem.OptimizationProblem) error {
+	model, err := formats.ModelFrom(prob)
+	if err != nil {
+		return fmt.Errorf("WriteProblem: %v", err)
+	}
+	return Write(w, model)
+}
+
+/*
+checkNames
+Description:
+
+	Verifies that the names are unique and can be read back as names
+	(i.e., they do not contain whitespace or operators, do not start with a digit
+	or a period and are not keywords of the format).
+*/
+func checkNames(kind string, names []string) error {
+	seen := map[string]bool{}
+	for _, name := range names {
+		lowerName := strings.ToLower(name)
+		_, isKeyword := sectionKeywords[lowerName]
+		switch {
+		case name == "":
+			return fmt.Errorf("Write: a %v has an empty name", kind)
+		case seen[name]:
+			return fmt.Errorf("Write: the %v name \"%v\" is used more than once", kind, name)
+		case strings.ContainsAny(name, " \t\r"+nameDelimiters) || isDigit(name[0]) || name[0] == '.':
+			return fmt.Errorf("Write: the %v name \"%v\" cannot be written in the LP format", kind, name)
+		case isKeyword || lowerName == "free" || lowerName == "inf" || lowerName == "infinity":
+			return fmt.Errorf("Write: the %v name \"%v\" is a keyword of the LP format", kind, name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+/*
+formatExpression
+Description:
+
+	Writes the linear expression after the given prefix, continuing long
+	expressions on new lines (each of which starts with a sign).
+*/
+func formatExpression(prefix string, idxs []int, coeffs []float64, constant float64, names []string) string {
+	// Setup
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	lineLength := len(prefix)
+
+	// Create the terms
+	terms := []string{}
+	for kk, varIdx := range idxs {
+		coeff := coeffs[kk]
+		term := names[varIdx]
+		if abs := max(coeff, -coeff); abs != 1 {
+			term = formatNumber(abs) + " " + term
+		}
+		terms = append(terms, signOf(coeff)+term)
+	}
+	if constant != 0 || len(terms) == 0 {
+		terms = append(terms, signOf(constant)+formatNumber(max(constant, -constant)))
+	}
+
+	for kk, term := range terms {
+		// The first term does not need a "+"
+		if kk == 0 {
+			term = strings.TrimPrefix(term, "+ ")
+		} else if lineLength+len(term) > maxLineLength {
+			sb.WriteString("\n ")
+			lineLength = 1
+		} else {
+			term = " " + term
+		}
+		sb.WriteString(term)
+		lineLength += len(term)
+	}
+
+	return sb.String()
+}
+
+// signOf returns the sign with which a term with the given coefficient is written.
+func signOf(coeff float64) string {
+	if coeff < 0 {
+		return "- "
+	}
+	return "+ "
+}
+
+/*
+formatBound
+Description:
+
+	Returns the statement of the Bounds section for one variable or an empty
+	string if the variable has the default bounds [0, +inf) or is binary.
+*/
+func formatBound(name string, v symbolic.Variable) string {
+	// Setup
+	hasLower, hasUpper := formats.IsFinite(v.Lower), formats.IsFinite(v.Upper)
+
+	switch {
+	case v.Type == symbolic.Binary:
+		return ""
+	case hasLower && hasUpper && v.Lower == v.Upper:
+		return fmt.Sprintf("%v = %v", name, formatNumber(v.Lower))
+	case !hasLower && !hasUpper:
+		return fmt.Sprintf("%v free", name)
+	case hasLower && hasUpper, !hasLower:
+		return fmt.Sprintf("%v <= %v <= %v", formatNumber(v.Lower), name, formatNumber(v.Upper))
+	case v.Lower != 0:
+		return fmt.Sprintf("%v >= %v", name, formatNumber(v.Lower))
+	}
+	return ""
+}
+
+/*
+formatNumber
+Description:
+
+	Formats a number with as many digits as are needed to read it back exactly.
+	Infinite values are written as +inf and -inf.
+*/
+func formatNumber(value float64) string {
+	if !formats.IsFinite(value) {
+		if value < 0 {
+			return "-inf"
+		}
+		return "+inf"
+	}
+	if value == 0 {
+		return "0" // Avoids writing -0
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,228 @@
+package formats
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	getKVector "github.com/MatProGo-dev/SymbolicMath.go/get/KVector"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+// InfinityThreshold is the magnitude at and above which a bound or right hand side
+// read from a model file is treated as infinite.
+const InfinityThreshold = 1e30
+
+/*
+Model
+Description:
+
+	An optimization problem whose variables and constraints have names.
+	Model files (MPS, LP, ...) refer to variables and constraints by name, while
+	problem.OptimizationProblem refers to variables by their IDs. The Model keeps
+	the mapping between the two so that solutions can be reported by name.
+*/
+type Model struct {
+	Problem *problem.OptimizationProblem
+	// VariableIDs maps the name of each variable to the ID of the symbolic.Variable in Problem.
+	VariableIDs map[string]uint64
+	// ConstraintNames contains the name of each constraint in Problem.Constraints (in the same order).
+	// Both halves of a ranged row have the same name.
+	ConstraintNames []string
+}
+
+/*
+NewModel
+Description:
+
+	Creates an empty model whose problem has the given name.
+*/
+func NewModel(name string) *Model {
+	return &Model{
+		Problem:         problem.NewProblem(name),
+		VariableIDs:     map[string]uint64{},
+		ConstraintNames: []string{},
+	}
+}
+
+/*
+AddVariable
+Description:
+
+	Adds a variable with the given name, bounds and type to the model's problem.
+	Infinite bounds should be given as +/- symbolic.Infinity.
+	Returns an error if a variable with the same name already exists or if the
+	lower bound is not less than the upper bound. In particular, fixed variables
+	(e.g., with an FX bound) are rejected, because they need an equality constraint,
+	which the solvers do not support (they start from the slack basis).
+*/
+func (m *Model) AddVariable(name string, lower, upper float64, vtype symbolic.VarType) (symbolic.Variable, error) {
+	// Input Processing
+	if _, found := m.VariableIDs[name]; found {
+		return symbolic.Variable{}, fmt.Errorf("the model already contains a variable named \"%v\"", name)
+	}
+	if lower == upper {
+		return symbolic.Variable{}, fmt.Errorf(
+			"the variable \"%v\" is fixed at %v, which needs an equality constraint (not supported by the solvers); substitute the value into the model instead",
+			name,
+			lower,
+		)
+	}
+	if lower > upper {
+		return symbolic.Variable{}, fmt.Errorf(
+			"the lower bound %v of the variable \"%v\" is greater than its upper bound %v",
+			lower,
+			name,
+			upper,
+		)
+	}
+
+	// Create the variable
+	m.Problem.AddVariableClassic(lower, upper, vtype)
+	nVariables := len(m.Problem.Variables)
+	m.Problem.Variables[nVariables-1].Name = name
+
+	newVariable := m.Problem.Variables[nVariables-1]
+	m.VariableIDs[name] = newVariable.ID
+
+	return newVariable, nil
+}
+
+/*
+AddConstraint
+Description:
+
+	Appends the constraint to the model's problem under the given name.
+*/
+func (m *Model) AddConstraint(name string, constraint symbolic.Constraint) {
+	m.Problem.Constraints = append(m.Problem.Constraints, constraint)
+	m.ConstraintNames = append(m.ConstraintNames, name)
+}
+
+/*
+AddBoundConstraints
+Description:
+
+	The standard form used by the solvers in this module only takes the sign of a
+	variable's bounds into account. This method makes every other bound of the
+	variable explicit by adding the constraints
+		v >= Lower	(named "<name>_lb") if Lower is finite and non-zero, and
+		v <= Upper	(named "<name>_ub") if Upper is finite.
+	Returns an error if the variable is fixed (see AddVariable).
+*/
+func (m *Model) AddBoundConstraints(v symbolic.Variable) error {
+	// Fixed variables
+	if v.Lower == v.Upper {
+		return fmt.Errorf(
+			"the variable \"%v\" is fixed at %v, which needs an equality constraint (not supported by the solvers); substitute the value into the model instead",
+			v.Name,
+			v.Lower,
+		)
+	}
+
+	// Lower bound
+	if IsFinite(v.Lower) && v.Lower != 0 {
+		m.AddConstraint(v.Name+"_lb", v.GreaterEq(v.Lower))
+	}
+
+	// Upper bound
+	if IsFinite(v.Upper) {
+		m.AddConstraint(v.Name+"_ub", v.LessEq(v.Upper))
+	}
+
+	return nil
+}
+
+/*
+Variable
+Description:
+
+	Returns the variable with the given name.
+*/
+func (m *Model) Variable(name string) (symbolic.Variable, error) {
+	id, found := m.VariableIDs[name]
+	if !found {
+		return symbolic.Variable{}, fmt.Errorf("the model does not contain a variable named \"%v\"", name)
+	}
+
+	for _, v := range m.Problem.Variables {
+		if v.ID == id {
+			return v, nil
+		}
+	}
+
+	return symbolic.Variable{}, fmt.Errorf("the variable \"%v\" (ID %v) is missing from the problem", name, id)
+}
+
+/*
+ValuesByName
+Description:
+
+	Translates a map from variable IDs to values (e.g., SimplexSolution.VariableValues)
+	into a map from variable names to values. IDs that do not belong to a named
+	variable are ignored.
+*/
+func (m *Model) ValuesByName(values map[uint64]float64) map[string]float64 {
+	out := map[string]float64{}
+	for name, id := range m.VariableIDs {
+		if value, found := values[id]; found {
+			out[name] = value
+		}
+	}
+	return out
+}
+
+/*
+LinearExpression
+Description:
+
+	Creates the scalar expression
+		coeffs[0] * vars[0] + coeffs[1] * vars[1] + ... + constant
+*/
+func LinearExpression(coeffs []float64, vars []symbolic.Variable, constant float64) symbolic.ScalarExpression {
+	// Input Processing
+	if len(coeffs) != len(vars) {
+		panic(
+			fmt.Errorf(
+				"LinearExpression: the number of coefficients (%v) does not match the number of variables (%v)",
+				len(coeffs),
+				len(vars),
+			),
+		)
+	}
+
+	// Create the expression
+	var out symbolic.Expression = symbolic.K(constant)
+	if len(vars) > 0 {
+		out = getKVector.From(coeffs).Transpose().Multiply(symbolic.VariableVector(vars))
+		if constant != 0 {
+			out = out.Plus(constant)
+		}
+	}
+
+	return out.(symbolic.ScalarExpression)
+}
+
+/*
+IsFinite
+Description:
+
+	Returns true if the value's magnitude is below InfinityThreshold.
+*/
+func IsFinite(value float64) bool {
+	return math.Abs(value) < InfinityThreshold
+}
+
+/*
+ToBound
+Description:
+
+	Converts a number read from a model file into a bound, mapping values
+	whose magnitude is at least InfinityThreshold to +/- symbolic.Infinity.
+*/
+func ToBound(value float64) float64 {
+	if IsFinite(value) {
+		return value
+	}
+	return math.Copysign(symbolic.Infinity.Constant(), value)
+}
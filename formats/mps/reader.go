@@ -0,0 +1,594 @@
+package mps_format
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+)
+
+/*
+Format
+Description:
+
+	The flavor of MPS to read or write.
+	- Fixed: fields are located at fixed column positions (names may contain spaces).
+	- Free: fields are separated by whitespace.
+*/
+type Format int
+
+const (
+	Fixed Format = iota
+	Free
+)
+
+// The (0-indexed, half-open) column ranges of the six fields of a line in fixed MPS.
+var fixedFieldRanges = [6][2]int{{1, 3}, {4, 12}, {14, 22}, {24, 36}, {39, 47}, {49, 61}}
+
+/*
+ParseError
+Description:
+
+	Describes a problem found on a specific line of an MPS file.
+*/
+type ParseError struct {
+	Line    int
+	Message string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("MPS parse error on line %v: %v", e.Line, e.Message)
+}
+
+// mpsRow collects the information about one row of the ROWS section.
+type mpsRow struct {
+	name     string
+	kind     string // "N", "L", "G" or "E"
+	colIdxs  []int
+	coeffs   []float64
+	rhs      float64
+	hasRange bool
+	rng      float64
+}
+
+// mpsColumn collects the information about one column of the COLUMNS section.
+type mpsColumn struct {
+	name  string
+	lower float64
+	upper float64
+	vtype symbolic.VarType
+	// hasLowerBound is set once a BOUNDS line gives the column a lower bound
+	hasLowerBound bool
+}
+
+// mpsReader holds the state of the parser.
+type mpsReader struct {
+	format Format
+	line   int
+
+	name        string
+	sense       problem.ObjSense
+	rows        []*mpsRow
+	rowIndex    map[string]int
+	objective   *mpsRow
+	objConstant float64
+	columns     []*mpsColumn
+	colIndex    map[string]int
+
+	inIntegerBlock bool
+	rhsSet         string
+	rangeSet       string
+	boundSet       string
+}
+
+/*
+ReadFile
+Description:
+
+	Opens the given file and reads it with Read.
+*/
+func ReadFile(filename string, format Format) (*formats.Model, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Read(file, format)
+}
+
+/*
+Read
+Description:
+
+	Parses a model in fixed or free MPS format and converts it into a formats.Model.
+	The NAME, OBJSENSE, ROWS, COLUMNS, RHS, RANGES and BOUNDS sections are supported.
+	The first N row is the objective (a right hand side on it is the negated objective
+	constant) and other N rows are ignored. Only the first RHS, RANGES and BOUNDS set is used.
+	Bounds are stored on the variables and, because the solvers in this module only use
+	the sign of a variable's bounds, also added as explicit constraints (see
+	formats.Model.AddBoundConstraints). A ranged row becomes two constraints with the same name.
+*/
+func Read(r io.Reader, format Format) (*formats.Model, error) {
+	// Setup
+	reader := &mpsReader{
+		format:   format,
+		sense:    problem.SenseMinimize,
+		rowIndex: map[string]int{},
+		colIndex: map[string]int{},
+	}
+
+	// Parse each line
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	section := ""
+	ended := false
+	for scanner.Scan() {
+		reader.line++
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+
+		// Skip comments and empty lines
+		if len(line) == 0 || line[0] == '*' || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		// Section headers start in the first column
+		if line[0] != ' ' && line[0] != '\t' {
+			fields := strings.Fields(line)
+			section = strings.ToUpper(fields[0])
+			switch section {
+			case "NAME":
+				reader.name = strings.TrimSpace(line[len(fields[0]):])
+			case "OBJSENSE", "OBJSENCE":
+				section = "OBJSENSE"
+				if len(fields) > 1 {
+					err := reader.parseObjSense(fields[1])
+					if err != nil {
+						return nil, err
+					}
+				}
+			case "ROWS", "COLUMNS", "RHS", "RANGES", "BOUNDS":
+			case "ENDATA":
+				ended = true
+			default:
+				return nil, ParseError{reader.line, fmt.Sprintf("unknown section \"%v\"", fields[0])}
+			}
+
+			if ended {
+				break
+			}
+			continue
+		}
+
+		// Parse the data lines of the current section
+		var err error
+		switch section {
+		case "OBJSENSE":
+			err = reader.parseObjSense(strings.TrimSpace(line))
+		case "ROWS":
+			err = reader.parseRowsLine(reader.fields(line))
+		case "COLUMNS":
+			err = reader.parseColumnsLine(reader.fieldsWithoutType(line))
+		case "RHS":
+			err = reader.parseRHSLine(reader.fieldsWithoutType(line))
+		case "RANGES":
+			err = reader.parseRangesLine(reader.fieldsWithoutType(line))
+		case "BOUNDS":
+			err = reader.parseBoundsLine(reader.fields(line))
+		default:
+			err = ParseError{reader.line, "data line outside of a section"}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if !ended {
+		return nil, ParseError{reader.line, "missing ENDATA"}
+	}
+
+	return reader.toModel()
+}
+
+/*
+fields
+Description:
+
+	Splits a data line into its fields according to the format of the reader.
+*/
+func (reader *mpsReader) fields(line string) []string {
+	if reader.format == Free {
+		return strings.Fields(line)
+	}
+
+	// Fixed format: the fields are located at fixed positions
+	out := []string{}
+	for _, fieldRange := range fixedFieldRanges {
+		if fieldRange[0] >= len(line) {
+			break
+		}
+		end := min(fieldRange[1], len(line))
+		out = append(out, strings.TrimSpace(line[fieldRange[0]:end]))
+	}
+
+	// Remove trailing empty fields
+	for len(out) > 0 && out[len(out)-1] == "" {
+		out = out[:len(out)-1]
+	}
+
+	return out
+}
+
+/*
+fieldsWithoutType
+Description:
+
+	Splits a data line of the COLUMNS, RHS or RANGES sections (which have no type field)
+	into its fields.
+*/
+func (reader *mpsReader) fieldsWithoutType(line string) []string {
+	fields := reader.fields(line)
+	if reader.format == Fixed && len(fields) > 0 {
+		return fields[1:]
+	}
+	return fields
+}
+
+func (reader *mpsReader) parseObjSense(value string) error {
+	switch strings.ToUpper(value) {
+	case "MIN", "MINIMIZE":
+		reader.sense = problem.SenseMinimize
+	case "MAX", "MAXIMIZE":
+		reader.sense = problem.SenseMaximize
+	default:
+		return ParseError{reader.line, fmt.Sprintf("unknown objective sense \"%v\"", value)}
+	}
+	return nil
+}
+
+func (reader *mpsReader) parseRowsLine(fields []string) error {
+	if len(fields) != 2 || fields[0] == "" {
+		return ParseError{reader.line, "a ROWS line must contain a row type and a row name"}
+	}
+
+	// Check the row
+	kind := strings.ToUpper(fields[0])
+	name := fields[1]
+	if kind != "N" && kind != "L" && kind != "G" && kind != "E" {
+		return ParseError{reader.line, fmt.Sprintf("unknown row type \"%v\"", fields[0])}
+	}
+	if _, found := reader.rowIndex[name]; found {
+		return ParseError{reader.line, fmt.Sprintf("duplicate row \"%v\"", name)}
+	}
+
+	// Add the row
+	row := &mpsRow{name: name, kind: kind}
+	reader.rowIndex[name] = len(reader.rows)
+	reader.rows = append(reader.rows, row)
+	if kind == "N" && reader.objective == nil {
+		reader.objective = row
+	}
+
+	return nil
+}
+
+func (reader *mpsReader) parseColumnsLine(fields []string) error {
+	// Integer markers
+	if len(fields) >= 3 && strings.Trim(fields[1], "'") == "MARKER" {
+		marker := ""
+		for _, field := range fields[2:] {
+			if field != "" {
+				marker = field
+				break
+			}
+		}
+
+		switch strings.Trim(marker, "'") {
+		case "INTORG":
+			reader.inIntegerBlock = true
+		case "INTEND":
+			reader.inIntegerBlock = false
+		default:
+			return ParseError{reader.line, fmt.Sprintf("unknown marker \"%v\"", marker)}
+		}
+		return nil
+	}
+
+	if len(fields) != 3 && len(fields) != 5 {
+		return ParseError{reader.line, "a COLUMNS line must contain a column name and one or two (row, value) pairs"}
+	}
+
+	// Find or create the column
+	colIdx, found := reader.colIndex[fields[0]]
+	if !found {
+		vtype := symbolic.Continuous
+		if reader.inIntegerBlock {
+			vtype = symbolic.Integer
+		}
+		colIdx = len(reader.columns)
+		reader.colIndex[fields[0]] = colIdx
+		reader.columns = append(reader.columns, &mpsColumn{
+			name:  fields[0],
+			lower: 0.0,
+			upper: symbolic.Infinity.Constant(),
+			vtype: vtype,
+		})
+	}
+
+	// Add the coefficients
+	return reader.forEachPair(fields[1:], func(row *mpsRow, value float64) {
+		row.colIdxs = append(row.colIdxs, colIdx)
+		row.coeffs = append(row.coeffs, value)
+	})
+}
+
+func (reader *mpsReader) parseRHSLine(fields []string) error {
+	// Remove the name of the RHS set (if any)
+	fields, keep := reader.selectSet(fields, &reader.rhsSet)
+	if !keep {
+		return nil
+	}
+
+	return reader.forEachPair(fields, func(row *mpsRow, value float64) {
+		if row == reader.objective {
+			reader.objConstant = -value
+			return
+		}
+		row.rhs = value
+	})
+}
+
+func (reader *mpsReader) parseRangesLine(fields []string) error {
+	// Remove the name of the RANGES set (if any)
+	fields, keep := reader.selectSet(fields, &reader.rangeSet)
+	if !keep {
+		return nil
+	}
+
+	return reader.forEachPair(fields, func(row *mpsRow, value float64) {
+		row.hasRange = true
+		row.rng = value
+	})
+}
+
+func (reader *mpsReader) parseBoundsLine(fields []string) error {
+	if len(fields) < 2 {
+		return ParseError{reader.line, "a BOUNDS line must contain a bound type and a column name"}
+	}
+
+	// Determine which fields are present
+	kind := strings.ToUpper(fields[0])
+	needsValue := false
+	switch kind {
+	case "UP", "LO", "FX", "LI", "UI":
+		needsValue = true
+	case "FR", "MI", "PL", "BV":
+	default:
+		return ParseError{reader.line, fmt.Sprintf("unsupported bound type \"%v\"", fields[0])}
+	}
+
+	rest := fields[1:]
+	hasSetName := len(rest) == 3 || (len(rest) == 2 && !needsValue)
+	if reader.format == Fixed {
+		hasSetName = true
+	}
+	if hasSetName {
+		if reader.boundSet == "" {
+			reader.boundSet = rest[0]
+		}
+		if rest[0] != reader.boundSet {
+			return nil
+		}
+		rest = rest[1:]
+	}
+
+	if len(rest) == 0 || (needsValue && len(rest) < 2) {
+		return ParseError{reader.line, fmt.Sprintf("the %v bound is missing a column name or value", kind)}
+	}
+
+	// Find the column
+	colIdx, found := reader.colIndex[rest[0]]
+	if !found {
+		return ParseError{reader.line, fmt.Sprintf("unknown column \"%v\"", rest[0])}
+	}
+	column := reader.columns[colIdx]
+
+	value := 0.0
+	if needsValue {
+		var err error
+		value, err = strconv.ParseFloat(rest[1], 64)
+		if err != nil {
+			return ParseError{reader.line, fmt.Sprintf("invalid bound value \"%v\"", rest[1])}
+		}
+		value = formats.ToBound(value)
+	}
+
+	// Apply the bound
+	switch kind {
+	case "UP", "UI":
+		column.upper = value
+		// By convention, a negative upper bound on a variable without a lower bound
+		// makes the variable unbounded below.
+		if value < 0 && !column.hasLowerBound {
+			column.lower = -symbolic.Infinity.Constant()
+		}
+	case "LO", "LI":
+		column.lower = value
+		column.hasLowerBound = true
+	case "FX":
+		column.lower, column.upper = value, value
+		column.hasLowerBound = true
+	case "FR":
+		column.lower, column.upper = -symbolic.Infinity.Constant(), symbolic.Infinity.Constant()
+		column.hasLowerBound = true
+	case "MI":
+		column.lower = -symbolic.Infinity.Constant()
+		column.hasLowerBound = true
+	case "PL":
+		column.upper = symbolic.Infinity.Constant()
+	case "BV":
+		column.lower, column.upper = 0.0, 1.0
+		column.vtype = symbolic.Binary
+		column.hasLowerBound = true
+	}
+
+	if kind == "LI" || kind == "UI" {
+		column.vtype = symbolic.Integer
+	}
+
+	return nil
+}
+
+/*
+selectSet
+Description:
+
+	Removes the set name from the fields of an RHS or RANGES line (if present).
+	The first set name that is seen is stored in `set`; lines of other sets are skipped
+	(keep is false).
+*/
+func (reader *mpsReader) selectSet(fields []string, set *string) ([]string, bool) {
+	hasSetName := len(fields)%2 == 1
+	if reader.format == Fixed {
+		hasSetName = true
+	}
+	if !hasSetName {
+		return fields, true
+	}
+
+	if *set == "" {
+		*set = fields[0]
+	}
+
+	return fields[1:], fields[0] == *set
+}
+
+/*
+forEachPair
+Description:
+
+	Calls apply for each (row name, value) pair in the fields.
+*/
+func (reader *mpsReader) forEachPair(fields []string, apply func(row *mpsRow, value float64)) error {
+	if len(fields) == 0 || len(fields)%2 != 0 {
+		return ParseError{reader.line, "expected one or two (row, value) pairs"}
+	}
+
+	for ii := 0; ii < len(fields); ii += 2 {
+		rowIdx, found := reader.rowIndex[fields[ii]]
+		if !found {
+			return ParseError{reader.line, fmt.Sprintf("unknown row \"%v\"", fields[ii])}
+		}
+
+		value, err := strconv.ParseFloat(fields[ii+1], 64)
+		if err != nil {
+			return ParseError{reader.line, fmt.Sprintf("invalid number \"%v\"", fields[ii+1])}
+		}
+
+		apply(reader.rows[rowIdx], value)
+	}
+
+	return nil
+}
+
+/*
+toModel
+Description:
+
+	Converts the parsed rows and columns into a formats.Model.
+*/
+func (reader *mpsReader) toModel() (*formats.Model, error) {
+	// Setup
+	model := formats.NewModel(reader.name)
+
+	// Create the variables
+	variables := make([]symbolic.Variable, len(reader.columns))
+	for ii, column := range reader.columns {
+		v, err := model.AddVariable(column.name, column.lower, column.upper, column.vtype)
+		if err != nil {
+			return nil, err
+		}
+		variables[ii] = v
+	}
+
+	// Create the objective
+	objective := formats.LinearExpression(nil, nil, reader.objConstant)
+	if reader.objective != nil {
+		objective = reader.rowExpression(reader.objective, variables, reader.objConstant)
+	}
+	err := model.Problem.SetObjective(objective, reader.sense)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the constraints
+	for _, row := range reader.rows {
+		if row.kind == "N" {
+			continue
+		}
+		expr := reader.rowExpression(row, variables, 0.0)
+
+		// Rows without a range
+		if !row.hasRange || (row.kind == "E" && row.rng == 0) {
+			switch row.kind {
+			case "L":
+				model.AddConstraint(row.name, expr.LessEq(row.rhs))
+			case "G":
+				model.AddConstraint(row.name, expr.GreaterEq(row.rhs))
+			case "E":
+				model.AddConstraint(row.name, expr.Eq(row.rhs))
+			}
+			continue
+		}
+
+		// Ranged rows become a pair of constraints: lower <= expr <= upper
+		var lower, upper float64
+		switch {
+		case row.kind == "L":
+			lower, upper = row.rhs-math.Abs(row.rng), row.rhs
+		case row.kind == "G":
+			lower, upper = row.rhs, row.rhs+math.Abs(row.rng)
+		case row.rng > 0:
+			lower, upper = row.rhs, row.rhs+row.rng
+		default:
+			lower, upper = row.rhs+row.rng, row.rhs
+		}
+		model.AddConstraint(row.name, expr.GreaterEq(lower))
+		model.AddConstraint(row.name, expr.LessEq(upper))
+	}
+
+	// Make the bounds explicit
+	for _, v := range variables {
+		err := model.AddBoundConstraints(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return model, nil
+}
+
+/*
+rowExpression
+Description:
+
+	Creates the linear expression of the given row.
+*/
+func (reader *mpsReader) rowExpression(row *mpsRow, variables []symbolic.Variable, constant float64) symbolic.ScalarExpression {
+	rowVariables := make([]symbolic.Variable, len(row.colIdxs))
+	for ii, colIdx := range row.colIdxs {
+		rowVariables[ii] = variables[colIdx]
+	}
+	return formats.LinearExpression(row.coeffs, rowVariables, constant)
+}
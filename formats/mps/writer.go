@@ -0,0 +1,446 @@
+package mps_format
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+	"github.com/MatProGo-dev/simplex/utils"
+	"gonum.org/v1/gonum/mat"
+)
+
+// The maximum number of characters of a name and of a number in fixed MPS.
+const (
+	fixedNameWidth   = 8
+	fixedNumberWidth = 12
+)
+
+// mpsWriter holds the state of the writer.
+type mpsWriter struct {
+	format Format
+	w      *bufio.Writer
+}
+
+/*
+WriteFile
+Description:
+
+	Writes the model to the file with the given name (see Write).
+*/
+func WriteFile(filename string, model *formats.Model, format Format) error {
+	// Create the file
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	err = Write(file, model, format)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+/*
+Write
+Description:
+
+	Writes the model in the given MPS format.
+	The objective sense is written in an OBJSENSE section, the objective constant
+	as the (negated) right hand side of the objective row, the bounds of each
+	variable in the BOUNDS section and integer variables between integer markers.
+	Constraints that are implied by the bounds of a single variable (e.g., the bound
+	constraints created by the reader) are omitted and the two halves of a ranged
+	row are written as a single row with a RANGES entry, so that
+	Read(Write(model)) describes the same problem as model.
+*/
+func Write(w io.Writer, model *formats.Model, format Format) error {
+	// Input Processing
+	if model == nil || model.Problem == nil {
+		return fmt.Errorf("Write: the model cannot be nil")
+	}
+
+	objective, objConstant, err := model.LinearObjective()
+	if err != nil {
+		return fmt.Errorf("Write: %v", err)
+	}
+
+	allRows, err := model.LinearRows()
+	if err != nil {
+		return fmt.Errorf("Write: %v", err)
+	}
+
+	// Select the rows to write
+	variables := model.Problem.Variables
+	rows := []formats.LinearRow{}
+	for _, row := range allRows {
+		if row.IsImpliedByVariableBounds(variables) || (!row.HasLower() && !row.HasUpper()) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	// Check the names
+	writer := &mpsWriter{format: format, w: bufio.NewWriter(w)}
+	columnNames := model.VariableNames()
+	if err := writer.checkNames("column", columnNames); err != nil {
+		return err
+	}
+
+	rowNames := make([]string, len(rows))
+	for ii, row := range rows {
+		rowNames[ii] = row.Name
+	}
+	objectiveName := uniqueName("OBJ", rowNames)
+	if err := writer.checkNames("row", append([]string{objectiveName}, rowNames...)); err != nil {
+		return err
+	}
+
+	// Collect the nonzero entries of each column
+	type entry struct {
+		row   string
+		value float64
+	}
+	entries := make([][]entry, len(variables))
+	for jj, coeff := range objective {
+		entries[jj] = append(entries[jj], entry{objectiveName, coeff})
+	}
+	for _, row := range rows {
+		for kk, varIdx := range row.VariableIndices {
+			entries[varIdx] = append(entries[varIdx], entry{row.Name, row.Coefficients[kk]})
+		}
+	}
+	for jj := range entries {
+		// Only keep the objective entry if the column would otherwise be empty
+		if len(entries[jj]) > 1 && entries[jj][0].value == 0 {
+			entries[jj] = entries[jj][1:]
+		}
+	}
+
+	// NAME and OBJSENSE
+	writer.writeHeader("NAME", model.Problem.Name)
+	if model.Problem.Objective.Sense == problem.SenseMaximize {
+		writer.writeHeader("OBJSENSE", "")
+		writer.w.WriteString("    MAX\n")
+	}
+
+	// ROWS
+	writer.writeHeader("ROWS", "")
+	writer.writeLine("N", objectiveName)
+	for _, row := range rows {
+		switch {
+		case row.HasLower() && row.HasUpper() && row.Lower == row.Upper:
+			writer.writeLine("E", row.Name)
+		case row.HasLower():
+			writer.writeLine("G", row.Name)
+		default:
+			writer.writeLine("L", row.Name)
+		}
+	}
+
+	// COLUMNS
+	writer.writeHeader("COLUMNS", "")
+	inIntegerBlock := false
+	for jj, v := range variables {
+		if isInteger := v.Type == symbolic.Integer; isInteger != inIntegerBlock {
+			marker := "'INTORG'"
+			if inIntegerBlock {
+				marker = "'INTEND'"
+			}
+			writer.writeLine("", "MARKER", "'MARKER'", "", marker)
+			inIntegerBlock = isInteger
+		}
+
+		for kk := 0; kk < len(entries[jj]); kk += 2 {
+			fields := []string{"", columnNames[jj], entries[jj][kk].row, writer.formatNumber(entries[jj][kk].value)}
+			if kk+1 < len(entries[jj]) {
+				fields = append(fields, entries[jj][kk+1].row, writer.formatNumber(entries[jj][kk+1].value))
+			}
+			writer.writeLine(fields...)
+		}
+	}
+	if inIntegerBlock {
+		writer.writeLine("", "MARKER", "'MARKER'", "", "'INTEND'")
+	}
+
+	// RHS
+	writer.writeHeader("RHS", "")
+	if objConstant != 0 {
+		writer.writeLine("", "RHS", objectiveName, writer.formatNumber(-objConstant))
+	}
+	for _, row := range rows {
+		rhs := row.Upper
+		if row.HasLower() {
+			rhs = row.Lower
+		}
+		if rhs != 0 {
+			writer.writeLine("", "RHS", row.Name, writer.formatNumber(rhs))
+		}
+	}
+
+	// RANGES
+	hasRanges := false
+	for _, row := range rows {
+		if !row.IsRanged() {
+			continue
+		}
+		if !hasRanges {
+			writer.writeHeader("RANGES", "")
+			hasRanges = true
+		}
+		writer.writeLine("", "RNG", row.Name, writer.formatNumber(row.Upper-row.Lower))
+	}
+
+	// BOUNDS
+	writer.writeHeader("BOUNDS", "")
+	for jj, v := range variables {
+		writer.writeBounds(columnNames[jj], v)
+	}
+
+	writer.writeHeader("ENDATA", "")
+
+	return writer.w.Flush()
+}
+
+/*
+WriteProblem
+Description:
+
+	Writes a problem that was not read from a model file.
+	The variables and constraints are named as described in formats.ModelFrom.
+*/
+func WriteProblem(w io.Writer, prob *problem.OptimizationProblem, format Format) error {
+	model, err := formats.ModelFrom(prob)
+	if err != nil {
+		return fmt.Errorf("WriteProblem: %v", err)
+	}
+	return Write(w, model, format)
+}
+
+/*
+WriteTableau
+Description:
+
+	Writes the problem represented by the tableau, i.e.
+		minimize	c^T * x - d
+		subject to	A * x = b
+					x >= 0
+	where [ c^T | d ] is the objective row of the tableau and [ A | b ] are its
+	constraint rows. Applied to the output of utils.GetInitialTableauFrom, this
+	exports the standard form that the solver works with.
+	Whitespace in the variable names (e.g., "x_2 (slack)") is replaced by underscores
+	and the constraint rows are named "R1", "R2", ... .
+*/
+func WriteTableau(w io.Writer, tableau utils.Tableau, name string, format Format) error {
+	// Input Processing
+	err := tableau.Check()
+	if err != nil {
+		return fmt.Errorf("WriteTableau: %v", err)
+	}
+
+	// Create the variables
+	model := formats.NewModel(name)
+	variables := make([]symbolic.Variable, len(tableau.Variables))
+	for ii, v := range tableau.Variables {
+		variables[ii], err = model.AddVariable(
+			strings.Join(strings.Fields(v.Name), "_"),
+			0.0, symbolic.Infinity.Constant(),
+			symbolic.Continuous,
+		)
+		if err != nil {
+			return fmt.Errorf("WriteTableau: %v", err)
+		}
+	}
+
+	// Create the objective
+	err = model.Problem.SetObjective(
+		formats.LinearExpression(vecToSlice(tableau.C()), variables, -tableau.D()),
+		problem.SenseMinimize,
+	)
+	if err != nil {
+		return fmt.Errorf("WriteTableau: %v", err)
+	}
+
+	// Create the constraints
+	A, b := tableau.A(), tableau.B()
+	for ii := 0; ii < tableau.NumberOfConstraints(); ii++ {
+		model.AddConstraint(
+			fmt.Sprintf("R%v", ii+1),
+			formats.LinearExpression(mat.Row(nil, ii, A), variables, 0.0).Eq(b.AtVec(ii)),
+		)
+	}
+
+	return Write(w, model, format)
+}
+
+/*
+vecToSlice
+Description:
+
+	Copies the entries of a vector into a slice.
+*/
+func vecToSlice(vec *mat.VecDense) []float64 {
+	out := make([]float64, vec.Len())
+	for ii := range out {
+		out[ii] = vec.AtVec(ii)
+	}
+	return out
+}
+
+/*
+uniqueName
+Description:
+
+	Returns base (with as many trailing underscores as needed) such that the
+	result does not appear in names.
+*/
+func uniqueName(base string, names []string) string {
+	taken := map[string]bool{}
+	for _, name := range names {
+		taken[name] = true
+	}
+
+	out := base
+	for taken[out] {
+		out += "_"
+	}
+	return out
+}
+
+/*
+checkNames
+Description:
+
+	Verifies that the names are unique and can be written in the writer's format.
+*/
+func (writer *mpsWriter) checkNames(kind string, names []string) error {
+	seen := map[string]bool{}
+	for _, name := range names {
+		switch {
+		case name == "":
+			return fmt.Errorf("Write: a %v has an empty name", kind)
+		case seen[name]:
+			return fmt.Errorf("Write: the %v name \"%v\" is used more than once", kind, name)
+		case writer.format == Free && strings.ContainsAny(name, " \t"):
+			return fmt.Errorf("Write: the %v name \"%v\" contains whitespace, which free MPS does not allow", kind, name)
+		case writer.format == Fixed && (len(name) > fixedNameWidth || strings.TrimSpace(name) != name):
+			return fmt.Errorf(
+				"Write: the %v name \"%v\" does not fit into the %v characters allowed by fixed MPS (use the free format)",
+				kind, name, fixedNameWidth,
+			)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+/*
+writeHeader
+Description:
+
+	Writes a section header (which starts in the first column).
+*/
+func (writer *mpsWriter) writeHeader(section string, value string) {
+	line := section
+	if value != "" {
+		if writer.format == Fixed {
+			line = fmt.Sprintf("%-14v%v", section, value)
+		} else {
+			line += " " + value
+		}
+	}
+	writer.w.WriteString(line + "\n")
+}
+
+/*
+writeLine
+Description:
+
+	Writes a data line whose fields are (type, name, name, number, name, number).
+	In the fixed format, each field is written at its column position. In the free
+	format, the empty fields are omitted.
+*/
+func (writer *mpsWriter) writeLine(fields ...string) {
+	var line string
+	if writer.format == Fixed {
+		padded := make([]any, 6)
+		for ii := range padded {
+			padded[ii] = ""
+			if ii < len(fields) {
+				padded[ii] = fields[ii]
+			}
+		}
+		line = fmt.Sprintf(" %-2v %-8v  %-8v  %12v   %-8v  %12v", padded...)
+	} else {
+		nonEmpty := []string{}
+		for _, field := range fields {
+			if field != "" {
+				nonEmpty = append(nonEmpty, field)
+			}
+		}
+		line = " " + strings.Join(nonEmpty, " ")
+	}
+	writer.w.WriteString(strings.TrimRight(line, " ") + "\n")
+}
+
+/*
+writeBounds
+Description:
+
+	Writes the BOUNDS lines of one variable. Nothing is written for the default
+	bounds [0, +inf) of a continuous or integer variable.
+*/
+func (writer *mpsWriter) writeBounds(name string, v symbolic.Variable) {
+	// Setup
+	hasLower, hasUpper := formats.IsFinite(v.Lower), formats.IsFinite(v.Upper)
+
+	switch {
+	case v.Type == symbolic.Binary:
+		writer.writeLine("BV", "BND", name)
+	case hasLower && hasUpper && v.Lower == v.Upper:
+		writer.writeLine("FX", "BND", name, writer.formatNumber(v.Lower))
+	case !hasLower && !hasUpper:
+		writer.writeLine("FR", "BND", name)
+	default:
+		// The upper bound is written first because a negative upper bound on a
+		// variable without a lower bound (yet) makes it unbounded below.
+		if hasUpper {
+			writer.writeLine("UP", "BND", name, writer.formatNumber(v.Upper))
+		}
+		if !hasLower {
+			writer.writeLine("MI", "BND", name)
+		} else if v.Lower != 0 || (hasUpper && v.Upper < 0) {
+			writer.writeLine("LO", "BND", name, writer.formatNumber(v.Lower))
+		}
+	}
+}
+
+/*
+formatNumber
+Description:
+
+	Formats a number with as many digits as are needed to read it back exactly.
+	In the fixed format, the number is rounded until it fits into its field.
+*/
+func (writer *mpsWriter) formatNumber(value float64) string {
+	if value == 0 {
+		return "0" // Avoids writing -0
+	}
+	out := strconv.FormatFloat(value, 'g', -1, 64)
+	if writer.format == Free {
+		return out
+	}
+
+	for precision := fixedNumberWidth; len(out) > fixedNumberWidth && precision > 0; precision-- {
+		out = strconv.FormatFloat(value, 'g', precision, 64)
+	}
+	return out
+}
@@ -0,0 +1,317 @@
+package formats
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+LinearRow
+Description:
+
+	A linear constraint of a model in the form
+		Lower <= sum_k Coefficients[k] * x_{VariableIndices[k]} <= Upper
+	where VariableIndices refers to Problem.Variables and missing bounds are
+	+/- symbolic.Infinity. Equality constraints have Lower == Upper.
+*/
+type LinearRow struct {
+	Name            string
+	VariableIndices []int
+	Coefficients    []float64
+	Lower           float64
+	Upper           float64
+}
+
+/*
+HasLower
+Description:
+
+	Returns true if the row has a finite lower bound.
+*/
+func (row LinearRow) HasLower() bool {
+	return IsFinite(row.Lower)
+}
+
+/*
+HasUpper
+Description:
+
+	Returns true if the row has a finite upper bound.
+*/
+func (row LinearRow) HasUpper() bool {
+	return IsFinite(row.Upper)
+}
+
+/*
+IsRanged
+Description:
+
+	Returns true if the row has two different finite bounds.
+*/
+func (row LinearRow) IsRanged() bool {
+	return row.HasLower() && row.HasUpper() && row.Lower != row.Upper
+}
+
+/*
+IsImpliedByVariableBounds
+Description:
+
+	Returns true if the row constrains a single variable and is already
+	implied by that variable's Lower and Upper bounds (e.g., the constraints
+	created by Model.AddBoundConstraints).
+*/
+func (row LinearRow) IsImpliedByVariableBounds(variables []symbolic.Variable) bool {
+	// Input Processing
+	if len(row.VariableIndices) != 1 || row.Coefficients[0] == 0 {
+		return false
+	}
+
+	// Convert the row into bounds on the variable
+	v := variables[row.VariableIndices[0]]
+	a := row.Coefficients[0]
+	lower, upper := row.Lower/a, row.Upper/a
+	if a < 0 {
+		lower, upper = upper, lower
+	}
+
+	impliedLower := !IsFinite(lower) || v.Lower >= lower
+	impliedUpper := !IsFinite(upper) || v.Upper <= upper
+	return impliedLower && impliedUpper
+}
+
+/*
+ModelFrom
+Description:
+
+	Wraps a problem that was not read from a model file in a Model.
+	Each variable keeps its name if it is non-empty and unique (otherwise it is
+	named "C<ID>") and the constraints are named "R0", "R1", ... .
+	The problem is not copied.
+*/
+func ModelFrom(prob *problem.OptimizationProblem) (*Model, error) {
+	// Input Processing
+	if prob == nil {
+		return nil, fmt.Errorf("ModelFrom: the problem cannot be nil")
+	}
+
+	// Name the variables
+	model := &Model{
+		Problem:         prob,
+		VariableIDs:     map[string]uint64{},
+		ConstraintNames: []string{},
+	}
+	for _, v := range prob.Variables {
+		name := strings.Join(strings.Fields(v.Name), "_")
+		if _, found := model.VariableIDs[name]; name == "" || found {
+			name = fmt.Sprintf("C%v", v.ID)
+		}
+		if _, found := model.VariableIDs[name]; found {
+			return nil, fmt.Errorf("ModelFrom: could not find a unique name for the variable with ID %v", v.ID)
+		}
+		model.VariableIDs[name] = v.ID
+	}
+
+	// Name the constraints
+	for ii := range prob.Constraints {
+		model.ConstraintNames = append(model.ConstraintNames, fmt.Sprintf("R%v", ii))
+	}
+
+	return model, nil
+}
+
+/*
+VariableNames
+Description:
+
+	Returns the name of each variable in Problem.Variables (in the same order).
+	Variables that are missing from VariableIDs are named "C<ID>".
+*/
+func (m *Model) VariableNames() []string {
+	// Invert the map from names to IDs
+	namesByID := map[uint64]string{}
+	for name, id := range m.VariableIDs {
+		namesByID[id] = name
+	}
+
+	out := make([]string, len(m.Problem.Variables))
+	for ii, v := range m.Problem.Variables {
+		name, found := namesByID[v.ID]
+		if !found {
+			name = fmt.Sprintf("C%v", v.ID)
+		}
+		out[ii] = name
+	}
+	return out
+}
+
+/*
+LinearObjective
+Description:
+
+	Returns the coefficient of each variable in Problem.Variables in the objective
+	and the objective's constant. Returns an error if the problem is not linear.
+*/
+func (m *Model) LinearObjective() ([]float64, float64, error) {
+	// Input Processing
+	if m.Problem.Objective.Expression == nil {
+		return make([]float64, len(m.Problem.Variables)), 0.0, nil
+	}
+
+	objective, ok := m.Problem.Objective.Expression.(symbolic.ScalarExpression)
+	if !ok || !m.Problem.IsLinear() {
+		return nil, 0.0, fmt.Errorf("LinearObjective: the objective is not a linear scalar expression")
+	}
+
+	// Extract the coefficients
+	c := objective.LinearCoeff(m.Problem.Variables)
+	out := make([]float64, c.Len())
+	for ii := range out {
+		out[ii] = c.AtVec(ii)
+	}
+	return out, objective.Constant(), nil
+}
+
+/*
+ScalarConstraintNames
+Description:
+
+	Returns the name of each scalar constraint of the model in the order given by
+	utils.ExtractScalarConstraints (which is also the order of SimplexSolution.DualValues).
+	The scalar constraints of a vector or matrix constraint are named
+	"<name>_0", "<name>_1", ... and constraints without a name are named "R<index>".
+*/
+func (m *Model) ScalarConstraintNames() []string {
+	// Setup
+	var out []string
+
+	for ii, constraint := range m.Problem.Constraints {
+		// Name the constraint
+		name := fmt.Sprintf("R%v", ii)
+		if ii < len(m.ConstraintNames) {
+			name = m.ConstraintNames[ii]
+		}
+
+		nScalarConstraints := len(utils.ExtractScalarConstraints([]symbolic.Constraint{constraint}))
+		for jj := 0; jj < nScalarConstraints; jj++ {
+			if nScalarConstraints > 1 {
+				out = append(out, fmt.Sprintf("%v_%v", name, jj))
+			} else {
+				out = append(out, name)
+			}
+		}
+	}
+
+	return out
+}
+
+/*
+LinearRows
+Description:
+
+	Converts every constraint of the model into a LinearRow.
+	Vector and matrix constraints are split into scalar constraints named
+	as described in ScalarConstraintNames and two consecutive constraints with the same name
+	and the same left hand side that bound it from below and from above (e.g., the two
+	halves of a ranged row created by a reader) are merged into a single ranged row.
+*/
+func (m *Model) LinearRows() ([]LinearRow, error) {
+	// Setup
+	var out []LinearRow
+	names := m.ScalarConstraintNames()
+	scalarConstraints := utils.ExtractScalarConstraints(m.Problem.Constraints)
+
+	for ii, scalarConstraint := range scalarConstraints {
+		row, err := m.linearRowFrom(names[ii], scalarConstraint)
+		if err != nil {
+			return nil, err
+		}
+
+		// Merge the two halves of a ranged row
+		if n := len(out); n > 0 && canMergeRows(out[n-1], row) {
+			out[n-1].Lower = math.Max(out[n-1].Lower, row.Lower)
+			out[n-1].Upper = math.Min(out[n-1].Upper, row.Upper)
+			continue
+		}
+
+		out = append(out, row)
+	}
+
+	return out, nil
+}
+
+/*
+linearRowFrom
+Description:
+
+	Converts a single linear scalar constraint into a LinearRow
+	by moving all of the constants to the right hand side.
+*/
+func (m *Model) linearRowFrom(name string, constraint symbolic.ScalarConstraint) (LinearRow, error) {
+	// Input Processing
+	if !constraint.IsLinear() {
+		return LinearRow{}, fmt.Errorf("the constraint \"%v\" is not linear", name)
+	}
+
+	// Move everything to the left hand side
+	expr := constraint.Left().Minus(constraint.Right()).(symbolic.ScalarExpression)
+	coeffs := expr.LinearCoeff(m.Problem.Variables)
+	rhs := -expr.Constant()
+
+	row := LinearRow{
+		Name:  name,
+		Lower: -symbolic.Infinity.Constant(),
+		Upper: symbolic.Infinity.Constant(),
+	}
+	for ii := 0; ii < coeffs.Len(); ii++ {
+		if coeffs.AtVec(ii) != 0 {
+			row.VariableIndices = append(row.VariableIndices, ii)
+			row.Coefficients = append(row.Coefficients, coeffs.AtVec(ii))
+		}
+	}
+
+	switch constraint.ConstrSense() {
+	case symbolic.SenseLessThanEqual:
+		row.Upper = rhs
+	case symbolic.SenseGreaterThanEqual:
+		row.Lower = rhs
+	case symbolic.SenseEqual:
+		row.Lower, row.Upper = rhs, rhs
+	default:
+		return LinearRow{}, fmt.Errorf("the constraint \"%v\" has an unsupported sense (%v)", name, constraint.ConstrSense())
+	}
+
+	return row, nil
+}
+
+/*
+canMergeRows
+Description:
+
+	Returns true if the two rows are the lower and upper halves of the same ranged row.
+*/
+func canMergeRows(first, second LinearRow) bool {
+	// The rows must bound the same expression from opposite sides
+	if first.Name != second.Name || first.HasLower() == second.HasLower() || first.HasUpper() == second.HasUpper() {
+		return false
+	}
+	if first.HasLower() && first.HasUpper() || second.HasLower() && second.HasUpper() {
+		return false
+	}
+
+	// Compare the left hand sides
+	if len(first.VariableIndices) != len(second.VariableIndices) {
+		return false
+	}
+	for ii := range first.VariableIndices {
+		if first.VariableIndices[ii] != second.VariableIndices[ii] || first.Coefficients[ii] != second.Coefficients[ii] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,357 @@
+package presolve
+
+import (
+	"fmt"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/simplex/formats"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+)
+
+/*
+postsolveState
+Description:
+
+	The primal values, dual values and basis statuses of the original problem's
+	columns and rows while the reductions are undone. Rows whose dual value has
+	not been restored yet have a dual value of 0.
+*/
+type postsolveState struct {
+	lp           *linearProgram
+	sense        problem.ObjSense
+	columnRows   [][]int
+	x            []float64
+	y            []float64
+	columnStatus []simplex_solution.BasisStatus
+	rowStatus    []simplex_solution.BasisStatus
+}
+
+/*
+reducedCost
+Description:
+
+	Returns the reduced cost c_j - sum_i y_i a_ij of a column of the original problem.
+*/
+func (s *postsolveState) reducedCost(jj int) float64 {
+	out := s.lp.costs[jj]
+	for _, ii := range s.columnRows[jj] {
+		out -= s.y[ii] * s.lp.rows[ii].coeffs[jj]
+	}
+	return out
+}
+
+/*
+prefersLower
+Description:
+
+	Returns +1 if the objective improves when the column with the given reduced cost
+	decreases, -1 if it improves when the column increases and 0 otherwise.
+*/
+func (s *postsolveState) prefersLower(reducedCost float64) int {
+	if s.sense == problem.SenseMaximize {
+		reducedCost = -reducedCost
+	}
+	switch {
+	case reducedCost > Tolerance:
+		return 1
+	case reducedCost < -Tolerance:
+		return -1
+	}
+	return 0
+}
+
+/*
+activeStatus
+Description:
+
+	Returns the status of an original row whose activity is at one of its bounds:
+	at its upper limit for <= rows and at its lower limit otherwise.
+*/
+func (s *postsolveState) activeStatus(ii int) simplex_solution.BasisStatus {
+	row := s.lp.rows[ii]
+	if formats.IsFinite(row.upper) && !formats.IsFinite(row.lower) {
+		return simplex_solution.BasisStatusAtUpper
+	}
+	return simplex_solution.BasisStatusAtLower
+}
+
+/*
+reduction
+Description:
+
+	A reduction made by presolve that postsolve can undo.
+*/
+type reduction interface {
+	undo(s *postsolveState)
+}
+
+/*
+emptyRow
+Description:
+
+	A row without columns. Its dual value is 0.
+*/
+type emptyRow struct {
+	row int
+}
+
+func (red *emptyRow) undo(s *postsolveState) {
+	s.y[red.row] = 0
+	s.rowStatus[red.row] = simplex_solution.BasisStatusBasic
+}
+
+/*
+redundantRow
+Description:
+
+	A row that is implied by the bounds of its columns. Its dual value is 0.
+*/
+type redundantRow struct {
+	row int
+}
+
+func (red *redundantRow) undo(s *postsolveState) {
+	s.y[red.row] = 0
+	s.rowStatus[red.row] = simplex_solution.BasisStatusBasic
+}
+
+/*
+singletonRow
+Description:
+
+	A row with a single column that was turned into bounds on the column.
+	setsLower (setsUpper) is true if the row tightened the column's lower (upper) bound to lower (upper).
+	If the column ends at a bound that the row set and its reduced cost pushes it against that
+	bound, the reduced cost is moved into the row's dual value.
+*/
+type singletonRow struct {
+	row         int
+	column      int
+	coefficient float64
+	setsLower   bool
+	lower       float64
+	setsUpper   bool
+	upper       float64
+}
+
+func (red *singletonRow) undo(s *postsolveState) {
+	// Setup
+	jj := red.column
+	d := s.reducedCost(jj)
+	s.y[red.row] = 0
+	s.rowStatus[red.row] = simplex_solution.BasisStatusBasic
+
+	atLower := red.setsLower && isClose(s.x[jj], red.lower)
+	atUpper := red.setsUpper && isClose(s.x[jj], red.upper)
+	switch direction := s.prefersLower(d); {
+	case direction > 0 && atLower, direction < 0 && atUpper:
+		s.y[red.row] = d / red.coefficient
+		s.rowStatus[red.row] = s.activeStatus(red.row)
+		s.columnStatus[jj] = simplex_solution.BasisStatusBasic
+	}
+}
+
+/*
+duplicateRow
+Description:
+
+	A row (removed) that is ratio times another row (kept) and was merged into it.
+	coeffs are the kept row's coefficients and lower and upper its bounds after the merge.
+	If the kept row ends at a bound that came from the removed row, the kept row's dual value
+	is moved to the removed row.
+*/
+type duplicateRow struct {
+	kept      int
+	removed   int
+	ratio     float64
+	coeffs    map[int]float64
+	setsLower bool
+	lower     float64
+	setsUpper bool
+	upper     float64
+}
+
+func (red *duplicateRow) undo(s *postsolveState) {
+	// Setup
+	s.y[red.removed] = 0
+	s.rowStatus[red.removed] = simplex_solution.BasisStatusBasic
+	if s.y[red.kept] == 0 {
+		return
+	}
+
+	act := activity(red.coeffs, s.x)
+	if (red.setsLower && isClose(act, red.lower)) || (red.setsUpper && isClose(act, red.upper)) {
+		s.y[red.removed] = s.y[red.kept] / red.ratio
+		s.rowStatus[red.removed] = s.activeStatus(red.removed)
+		s.y[red.kept] = 0
+		s.rowStatus[red.kept] = simplex_solution.BasisStatusBasic
+	}
+}
+
+/*
+fixedColumn
+Description:
+
+	A column that was fixed at value (because its bounds are equal or it appears in no row).
+*/
+type fixedColumn struct {
+	column int
+	value  float64
+	status simplex_solution.BasisStatus
+}
+
+func (red *fixedColumn) undo(s *postsolveState) {
+	s.x[red.column] = red.value
+	s.columnStatus[red.column] = red.status
+}
+
+/*
+freeColumnSingleton
+Description:
+
+	A free column that was eliminated together with the only row in which it appears.
+	cost is the column's cost and coeffs, lower and upper describe the row at the time of the
+	elimination. The column takes the value that puts the row's activity as close as possible
+	to the activity of the other columns (exactly on the bound for equality rows) and the row's
+	dual value makes the column's reduced cost 0.
+*/
+type freeColumnSingleton struct {
+	column int
+	row    int
+	cost   float64
+	coeffs map[int]float64
+	lower  float64
+	upper  float64
+}
+
+func (red *freeColumnSingleton) undo(s *postsolveState) {
+	// Setup
+	jj := red.column
+	a := red.coeffs[jj]
+
+	// Primal value
+	others := 0.0
+	for kk, ak := range red.coeffs {
+		if kk != jj {
+			others += ak * s.x[kk]
+		}
+	}
+	target := max(red.lower, min(others, red.upper))
+	s.x[jj] = (target - others) / a
+	s.columnStatus[jj] = simplex_solution.BasisStatusBasic
+
+	// Dual value
+	s.y[red.row] = red.cost / a
+	s.rowStatus[red.row] = simplex_solution.BasisStatusBasic
+	if red.lower == red.upper {
+		s.rowStatus[red.row] = simplex_solution.BasisStatusAtLower
+	}
+}
+
+/*
+Postsolve
+Description:
+
+	Maps a solution of the reduced problem (Problem) back to the original problem:
+	the values of the removed variables and the dual values of the removed constraints
+	are restored by undoing the reductions in reverse order, the reduced costs are computed
+	from the original problem and the basis is mapped as far as possible.
+	If presolve decided the problem (Problem is nil), reduced is ignored and the solution
+	has presolve's status. The solution's statistics include the presolve statistics.
+*/
+func (r *Result) Postsolve(reduced simplex_solution.SimplexSolution) (simplex_solution.SimplexSolution, error) {
+	// Setup
+	out := reduced
+	out.OriginalProblem = r.original
+	stats := r.Statistics
+	out.Statistics.Presolve = &stats
+
+	// Input Processing
+	switch {
+	case r.Status == solution_status.INFEASIBLE || r.Status == solution_status.INF_OR_UNBD:
+		out.Status = r.Status
+		out.VariableValues, out.DualValues, out.ReducedCosts, out.Basis = nil, nil, nil, nil
+		return out, out.EvaluateAtVariableValues()
+	case r.Problem == nil:
+		out.Status = r.Status
+		out.Iterations = 0
+		reduced = simplex_solution.SimplexSolution{DualValues: []float64{}, Basis: &simplex_solution.Basis{}}
+	case reduced.VariableValues == nil:
+		return out, fmt.Errorf("Postsolve: the solution of the reduced problem has no variable values")
+	}
+
+	s := &postsolveState{
+		lp:           r.originalLP,
+		sense:        r.original.Objective.Sense,
+		columnRows:   r.originalLP.columnRows(),
+		x:            make([]float64, len(r.originalLP.costs)),
+		y:            make([]float64, len(r.originalLP.rows)),
+		columnStatus: make([]simplex_solution.BasisStatus, len(r.originalLP.costs)),
+		rowStatus:    make([]simplex_solution.BasisStatus, len(r.originalLP.rows)),
+	}
+
+	// Values and statuses of the remaining columns
+	for jj, v := range r.reducedVariables {
+		s.x[jj] = reduced.VariableValues[v.ID]
+		if reduced.Basis != nil {
+			s.columnStatus[jj] = reduced.Basis.VariableStatus[v.ID]
+		}
+	}
+
+	// Dual values and statuses of the remaining rows
+	for ii, indices := range r.reducedRows {
+		s.rowStatus[ii] = simplex_solution.BasisStatusBasic
+		for side, index := range indices {
+			if index == -1 || (side == 1 && indices[0] == index) {
+				continue
+			}
+			if index < len(reduced.DualValues) {
+				s.y[ii] += reduced.DualValues[index]
+			}
+			if reduced.Basis != nil && index < len(reduced.Basis.ConstraintStatus) {
+				if status := reduced.Basis.ConstraintStatus[index]; status != simplex_solution.BasisStatusBasic {
+					s.rowStatus[ii] = status
+				}
+			}
+		}
+	}
+
+	// Undo the reductions
+	for kk := len(r.reductions) - 1; kk >= 0; kk-- {
+		r.reductions[kk].undo(s)
+	}
+
+	// Assemble the solution
+	out.VariableValues = map[uint64]float64{}
+	for jj, v := range r.original.Variables {
+		out.VariableValues[v.ID] = s.x[jj]
+	}
+
+	err := out.EvaluateAtVariableValues()
+	if err != nil {
+		return out, fmt.Errorf("Postsolve: %v", err)
+	}
+
+	out.DualValues, out.ReducedCosts = nil, nil
+	if reduced.DualValues != nil {
+		out.DualValues = s.y
+		out.ReducedCosts = map[uint64]float64{}
+		for jj, v := range r.original.Variables {
+			out.ReducedCosts[v.ID] = s.reducedCost(jj)
+		}
+	}
+
+	out.Basis = nil
+	if reduced.Basis != nil {
+		out.Basis = &simplex_solution.Basis{
+			VariableStatus:   map[uint64]simplex_solution.BasisStatus{},
+			ConstraintStatus: s.rowStatus,
+		}
+		for jj, v := range r.original.Variables {
+			out.Basis.VariableStatus[v.ID] = s.columnStatus[jj]
+		}
+	}
+
+	return out, nil
+}
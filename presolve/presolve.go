@@ -0,0 +1,616 @@
+/*
+Package presolve reduces a linear problem before it is solved and maps the
+solution of the reduced problem back to the original problem (postsolve).
+
+The reductions are:
+  - Empty rows are removed (or prove that the problem is infeasible).
+  - Singleton rows are turned into bounds on their variable.
+  - Rows that are implied by the bounds of their variables are removed
+    (and rows that cannot be satisfied prove that the problem is infeasible).
+  - Duplicate rows (multiples of another row) are merged into that row.
+  - Variables with equal bounds are fixed and substituted into the rows.
+  - Variables that appear in no row are fixed at their best bound
+    (or prove that the problem is infeasible or unbounded).
+  - Free variables that appear in a single row are eliminated together with
+    the row if the row is an equality or the variable has no cost.
+
+Every reduction is recorded so that Postsolve can restore the values of the
+removed variables and the dual values of the removed constraints.
+*/
+package presolve
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+)
+
+// Tolerance is the tolerance that presolve uses to compare bounds, activities and coefficients.
+const Tolerance = 1e-9
+
+/*
+Result
+Description:
+
+	The outcome of presolving a problem.
+	- Problem is the reduced problem that must be solved (nil if presolve decided the problem).
+	  It keeps the equality rows that could not be removed, which the simplex algorithms do
+	  not support (SimplexSolver returns an error for them).
+	- Status is LOADED if Problem must be solved, OPTIMAL if presolve removed every variable
+	  and INFEASIBLE or INF_OR_UNBD if presolve proved that the problem has no optimal solution.
+	- Statistics lists the reductions that were made.
+	Postsolve maps a solution of Problem back to the original problem.
+*/
+type Result struct {
+	Problem    *problem.OptimizationProblem
+	Status     solution_status.SolutionStatus
+	Statistics simplex_solution.PresolveStatistics
+
+	original   *problem.OptimizationProblem
+	originalLP *linearProgram
+	reduced    *linearProgram
+	reductions []reduction
+
+	// The variable of the reduced problem for each remaining column and the indices of the
+	// lower and upper halves of each remaining row in the reduced problem's constraints (-1 if missing)
+	reducedVariables map[int]symbolic.Variable
+	reducedRows      map[int][2]int
+}
+
+/*
+presolver
+Description:
+
+	The state of presolve: the linear program being reduced and the reductions so far.
+*/
+type presolver struct {
+	lp         *linearProgram
+	reductions []reduction
+	stats      simplex_solution.PresolveStatistics
+}
+
+/*
+Presolve
+Description:
+
+	Reduces a linear problem. The problem is not modified.
+	Returns an error if the problem is nil or not linear.
+*/
+func Presolve(prob *problem.OptimizationProblem) (*Result, error) {
+	// Input Processing
+	if prob == nil {
+		return nil, fmt.Errorf("Presolve: the problem cannot be nil")
+	}
+
+	lp, err := newLinearProgram(prob)
+	if err != nil {
+		return nil, fmt.Errorf("Presolve: %v", err)
+	}
+
+	// Reduce
+	p := &presolver{lp: lp.clone()}
+	p.stats.RowsBefore, p.stats.ColumnsBefore = len(lp.rows), len(lp.costs)
+	status := p.run(prob.Objective.Sense)
+
+	result := &Result{
+		Status:     status,
+		original:   prob,
+		originalLP: lp,
+		reduced:    p.lp,
+		reductions: p.reductions,
+	}
+
+	// Count what remains
+	for _, row := range p.lp.rows {
+		if row.active {
+			p.stats.RowsAfter++
+		}
+	}
+	for _, active := range p.lp.columnActive {
+		if active {
+			p.stats.ColumnsAfter++
+		}
+	}
+	result.Statistics = p.stats
+
+	// Build the reduced problem
+	switch {
+	case status != solution_status.LOADED:
+		return result, nil
+	case p.stats.ColumnsAfter == 0:
+		result.Status = solution_status.OPTIMAL
+		return result, nil
+	}
+
+	result.buildReducedProblem()
+	return result, nil
+}
+
+/*
+run
+Description:
+
+	Applies the reductions until none of them changes the problem.
+	Returns LOADED, or INFEASIBLE or INF_OR_UNBD if a reduction proves it.
+*/
+func (p *presolver) run(sense problem.ObjSense) solution_status.SolutionStatus {
+	for changed := true; changed; {
+		changed = false
+
+		// Rows
+		for ii := range p.lp.rows {
+			if !p.lp.rows[ii].active {
+				continue
+			}
+			rowChanged, status := p.reduceRow(ii)
+			if status != solution_status.LOADED {
+				return status
+			}
+			changed = changed || rowChanged
+		}
+
+		rowsChanged, status := p.mergeDuplicateRows()
+		if status != solution_status.LOADED {
+			return status
+		}
+		changed = changed || rowsChanged
+
+		// Columns
+		columnRows := p.lp.columnRows()
+		for jj := range p.lp.costs {
+			if !p.lp.columnActive[jj] {
+				continue
+			}
+			columnChanged, status := p.reduceColumn(jj, columnRows[jj], sense)
+			if status != solution_status.LOADED {
+				return status
+			}
+			changed = changed || columnChanged
+		}
+	}
+
+	return solution_status.LOADED
+}
+
+/*
+reduceRow
+Description:
+
+	Removes the row if it is empty, a singleton or redundant.
+	Returns true if the row was removed and INFEASIBLE if the row cannot be satisfied.
+*/
+func (p *presolver) reduceRow(ii int) (bool, solution_status.SolutionStatus) {
+	// Setup
+	row := &p.lp.rows[ii]
+
+	switch len(row.coeffs) {
+	case 0:
+		// Empty row: 0 must lie between the bounds
+		if exceeds(row.lower, 0) || exceeds(0, row.upper) {
+			return false, solution_status.INFEASIBLE
+		}
+		row.active = false
+		p.reductions = append(p.reductions, &emptyRow{row: ii})
+		p.stats.EmptyRows++
+		return true, solution_status.LOADED
+
+	case 1:
+		// Singleton row: convert it into bounds on its column
+		red := &singletonRow{row: ii}
+		for jj, a := range row.coeffs {
+			red.column, red.coefficient = jj, a
+		}
+		lower, upper := divideBound(row.lower, red.coefficient), divideBound(row.upper, red.coefficient)
+		if red.coefficient < 0 {
+			lower, upper = upper, lower
+		}
+
+		jj := red.column
+		if formats.IsFinite(lower) && lower > p.lp.lower[jj] {
+			p.lp.lower[jj], red.setsLower, red.lower = lower, true, lower
+		}
+		if formats.IsFinite(upper) && upper < p.lp.upper[jj] {
+			p.lp.upper[jj], red.setsUpper, red.upper = upper, true, upper
+		}
+		if exceeds(p.lp.lower[jj], p.lp.upper[jj]) {
+			return false, solution_status.INFEASIBLE
+		}
+
+		row.active = false
+		p.reductions = append(p.reductions, red)
+		p.stats.SingletonRows++
+		return true, solution_status.LOADED
+	}
+
+	// Compare the row's bounds with its activity bounds
+	minActivity, maxActivity := p.lp.activityBounds(*row)
+	if exceeds(minActivity, row.upper) || exceeds(row.lower, maxActivity) {
+		return false, solution_status.INFEASIBLE
+	}
+	if !exceeds(row.lower, minActivity) && !exceeds(maxActivity, row.upper) {
+		row.active = false
+		p.reductions = append(p.reductions, &redundantRow{row: ii})
+		p.stats.RedundantRows++
+		return true, solution_status.LOADED
+	}
+
+	return false, solution_status.LOADED
+}
+
+/*
+mergeDuplicateRows
+Description:
+
+	Merges every row that is a multiple of an earlier row into that row.
+	Returns true if a row was merged and INFEASIBLE if the merged bounds cross.
+*/
+func (p *presolver) mergeDuplicateRows() (bool, solution_status.SolutionStatus) {
+	// Group the rows by the columns that they contain
+	var keys []string
+	groups := map[string][]int{}
+	for ii, row := range p.lp.rows {
+		if !row.active || len(row.coeffs) < 2 {
+			continue
+		}
+		key := sparsityKey(row.coeffs)
+		if _, found := groups[key]; !found {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], ii)
+	}
+
+	// Compare the rows of each group
+	changed := false
+	for _, key := range keys {
+		group := groups[key]
+		for kk, kept := range group {
+			if !p.lp.rows[kept].active {
+				continue
+			}
+			for _, removed := range group[kk+1:] {
+				if !p.lp.rows[removed].active {
+					continue
+				}
+				ratio, ok := rowRatio(p.lp.rows[kept].coeffs, p.lp.rows[removed].coeffs)
+				if !ok {
+					continue
+				}
+				if status := p.mergeRows(kept, removed, ratio); status != solution_status.LOADED {
+					return false, status
+				}
+				changed = true
+			}
+		}
+	}
+
+	return changed, solution_status.LOADED
+}
+
+/*
+mergeRows
+Description:
+
+	Merges the row removed = ratio * kept into the row kept by intersecting their bounds.
+*/
+func (p *presolver) mergeRows(kept, removed int, ratio float64) solution_status.SolutionStatus {
+	// Setup
+	keptRow, removedRow := &p.lp.rows[kept], &p.lp.rows[removed]
+	red := &duplicateRow{
+		kept:    kept,
+		removed: removed,
+		ratio:   ratio,
+		coeffs:  copyCoefficients(keptRow.coeffs),
+	}
+
+	// Bounds of the removed row in terms of the kept row
+	lower, upper := divideBound(removedRow.lower, ratio), divideBound(removedRow.upper, ratio)
+	if ratio < 0 {
+		lower, upper = upper, lower
+	}
+	if formats.IsFinite(lower) && lower > keptRow.lower {
+		keptRow.lower, red.setsLower = lower, true
+	}
+	if formats.IsFinite(upper) && upper < keptRow.upper {
+		keptRow.upper, red.setsUpper = upper, true
+	}
+	if exceeds(keptRow.lower, keptRow.upper) {
+		return solution_status.INFEASIBLE
+	}
+	red.lower, red.upper = keptRow.lower, keptRow.upper
+
+	removedRow.active = false
+	p.reductions = append(p.reductions, red)
+	p.stats.DuplicateRows++
+	return solution_status.LOADED
+}
+
+/*
+reduceColumn
+Description:
+
+	Removes the column if it is fixed, empty or a free column singleton.
+	rows must contain every active row in which the column appears.
+	Returns true if the column was removed and INFEASIBLE or INF_OR_UNBD
+	if the column proves that the problem has no optimal solution.
+*/
+func (p *presolver) reduceColumn(jj int, rows []int, sense problem.ObjSense) (bool, solution_status.SolutionStatus) {
+	// Setup
+	lp := p.lp
+	var activeRows []int
+	for _, ii := range rows {
+		if _, found := lp.rows[ii].coeffs[jj]; lp.rows[ii].active && found {
+			activeRows = append(activeRows, ii)
+		}
+	}
+
+	switch {
+	case exceeds(lp.lower[jj], lp.upper[jj]):
+		return false, solution_status.INFEASIBLE
+
+	case formats.IsFinite(lp.lower[jj]) && isClose(lp.lower[jj], lp.upper[jj]):
+		// Fixed column
+		p.fixColumn(jj, lp.lower[jj], simplex_solution.BasisStatusAtLower, activeRows)
+		p.stats.FixedColumns++
+		return true, solution_status.LOADED
+
+	case len(activeRows) == 0:
+		// Empty column: fix it at its best bound
+		cost := lp.costs[jj]
+		if sense == problem.SenseMaximize {
+			cost = -cost
+		}
+
+		value := 0.0
+		switch {
+		case cost > 0 || (cost == 0 && lp.lower[jj] > 0):
+			value = lp.lower[jj]
+		case cost < 0 || (cost == 0 && lp.upper[jj] < 0):
+			value = lp.upper[jj]
+		}
+		if !formats.IsFinite(value) {
+			return false, solution_status.INF_OR_UNBD
+		}
+
+		status := simplex_solution.BasisStatusFree
+		switch value {
+		case lp.lower[jj]:
+			status = simplex_solution.BasisStatusAtLower
+		case lp.upper[jj]:
+			status = simplex_solution.BasisStatusAtUpper
+		}
+
+		p.fixColumn(jj, value, status, nil)
+		p.stats.EmptyColumns++
+		return true, solution_status.LOADED
+
+	case len(activeRows) == 1 && !formats.IsFinite(lp.lower[jj]) && !formats.IsFinite(lp.upper[jj]):
+		// Free column singleton
+		ii := activeRows[0]
+		row := &lp.rows[ii]
+		if row.lower != row.upper && lp.costs[jj] != 0 {
+			return false, solution_status.LOADED
+		}
+		p.eliminateColumn(jj, ii)
+		p.stats.SingletonColumns++
+		return true, solution_status.LOADED
+	}
+
+	return false, solution_status.LOADED
+}
+
+/*
+fixColumn
+Description:
+
+	Fixes a column at the given value and substitutes it into the objective and the given rows.
+*/
+func (p *presolver) fixColumn(jj int, value float64, status simplex_solution.BasisStatus, rows []int) {
+	// Setup
+	lp := p.lp
+
+	for _, ii := range rows {
+		row := &lp.rows[ii]
+		if formats.IsFinite(row.lower) {
+			row.lower -= row.coeffs[jj] * value
+		}
+		if formats.IsFinite(row.upper) {
+			row.upper -= row.coeffs[jj] * value
+		}
+		delete(row.coeffs, jj)
+	}
+	lp.objectiveConstant += lp.costs[jj] * value
+	lp.columnActive[jj] = false
+
+	p.reductions = append(p.reductions, &fixedColumn{column: jj, value: value, status: status})
+}
+
+/*
+eliminateColumn
+Description:
+
+	Eliminates the free column jj together with the only row ii in which it appears.
+	If the column has a cost (and the row is an equality), the column is replaced by
+	the other columns of the row in the objective.
+*/
+func (p *presolver) eliminateColumn(jj, ii int) {
+	// Setup
+	lp := p.lp
+	row := &lp.rows[ii]
+	a, cost := row.coeffs[jj], lp.costs[jj]
+
+	red := &freeColumnSingleton{
+		column: jj,
+		row:    ii,
+		cost:   cost,
+		coeffs: copyCoefficients(row.coeffs),
+		lower:  row.lower,
+		upper:  row.upper,
+	}
+
+	// Substitute x_j = (b - sum_{k != j} a_k x_k) / a_j into the objective
+	if cost != 0 {
+		for kk, ak := range row.coeffs {
+			if kk != jj {
+				lp.costs[kk] -= cost * ak / a
+			}
+		}
+		lp.objectiveConstant += cost * row.lower / a
+	}
+
+	row.active = false
+	lp.columnActive[jj] = false
+	p.reductions = append(p.reductions, red)
+}
+
+/*
+buildReducedProblem
+Description:
+
+	Creates the problem made of the remaining rows and columns.
+	Each remaining column becomes a variable with the same name and its presolved bounds.
+	Since the solver only uses the sign of a variable's bounds, every finite bound other than
+	a lower bound of 0 is also added as a constraint (after the rows). A ranged row becomes a
+	>= and a <= constraint.
+*/
+func (r *Result) buildReducedProblem() {
+	// Setup
+	lp := r.reduced
+	reduced := problem.NewProblem(r.original.Name)
+	r.reducedVariables = map[int]symbolic.Variable{}
+	r.reducedRows = map[int][2]int{}
+
+	// Variables
+	var columns []int
+	for jj, active := range lp.columnActive {
+		if !active {
+			continue
+		}
+		original := r.original.Variables[jj]
+		v := reduced.AddVariableClassic(lp.lower[jj], lp.upper[jj], original.Type)
+		v.Name = original.Name
+		reduced.Variables[len(reduced.Variables)-1].Name = original.Name
+		r.reducedVariables[jj] = v
+		columns = append(columns, jj)
+	}
+
+	// Objective
+	costs := make([]float64, len(columns))
+	vars := make([]symbolic.Variable, len(columns))
+	for kk, jj := range columns {
+		costs[kk], vars[kk] = lp.costs[jj], r.reducedVariables[jj]
+	}
+	reduced.SetObjective(formats.LinearExpression(costs, vars, lp.objectiveConstant), r.original.Objective.Sense)
+
+	// Rows
+	nConstraints := 0
+	for ii, row := range lp.rows {
+		if !row.active {
+			continue
+		}
+
+		indices := [2]int{-1, -1}
+		expr := r.reducedExpression(row.coeffs)
+		switch {
+		case row.lower == row.upper:
+			reduced.Constraints = append(reduced.Constraints, expr.Eq(row.lower))
+			indices = [2]int{nConstraints, nConstraints}
+			nConstraints++
+		default:
+			if formats.IsFinite(row.lower) {
+				reduced.Constraints = append(reduced.Constraints, expr.GreaterEq(row.lower))
+				indices[0] = nConstraints
+				nConstraints++
+			}
+			if formats.IsFinite(row.upper) {
+				reduced.Constraints = append(reduced.Constraints, expr.LessEq(row.upper))
+				indices[1] = nConstraints
+				nConstraints++
+			}
+		}
+		r.reducedRows[ii] = indices
+	}
+
+	// Bounds
+	for _, jj := range columns {
+		v := r.reducedVariables[jj]
+		if formats.IsFinite(lp.lower[jj]) && lp.lower[jj] != 0 {
+			reduced.Constraints = append(reduced.Constraints, v.GreaterEq(lp.lower[jj]))
+		}
+		if formats.IsFinite(lp.upper[jj]) {
+			reduced.Constraints = append(reduced.Constraints, v.LessEq(lp.upper[jj]))
+		}
+	}
+
+	r.Problem = reduced
+}
+
+/*
+reducedExpression
+Description:
+
+	Returns the expression sum_j coeffs[j] * x_j in terms of the reduced problem's variables.
+*/
+func (r *Result) reducedExpression(coeffs map[int]float64) symbolic.ScalarExpression {
+	// Setup
+	columns := make([]int, 0, len(coeffs))
+	for jj := range coeffs {
+		columns = append(columns, jj)
+	}
+	sort.Ints(columns)
+
+	values := make([]float64, len(columns))
+	vars := make([]symbolic.Variable, len(columns))
+	for kk, jj := range columns {
+		values[kk], vars[kk] = coeffs[jj], r.reducedVariables[jj]
+	}
+	return formats.LinearExpression(values, vars, 0)
+}
+
+/*
+sparsityKey
+Description:
+
+	Returns a key that identifies the columns of a row.
+*/
+func sparsityKey(coeffs map[int]float64) string {
+	columns := make([]int, 0, len(coeffs))
+	for jj := range coeffs {
+		columns = append(columns, jj)
+	}
+	sort.Ints(columns)
+
+	parts := make([]string, len(columns))
+	for kk, jj := range columns {
+		parts[kk] = fmt.Sprint(jj)
+	}
+	return strings.Join(parts, ",")
+}
+
+/*
+rowRatio
+Description:
+
+	Returns the ratio lambda such that other = lambda * row, if there is one.
+	Both rows must contain the same columns.
+*/
+func rowRatio(row, other map[int]float64) (float64, bool) {
+	// Setup
+	ratio := 0.0
+	for jj, a := range row {
+		ratio = other[jj] / a
+		break
+	}
+
+	for jj, a := range row {
+		if !isClose(other[jj], ratio*a) {
+			return 0, false
+		}
+	}
+	return ratio, true
+}
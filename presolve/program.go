@@ -0,0 +1,262 @@
+package presolve
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+linearProgram
+Description:
+
+	The data of a linear problem that presolve works on:
+		optimize   sum_j costs[j] * x_j + objectiveConstant
+		subject to rows[i].lower <= sum_j rows[i].coeffs[j] * x_j <= rows[i].upper
+		           lower[j] <= x_j <= upper[j]
+	Column j is the variable Problem.Variables[j] and row i is the i-th scalar constraint
+	in the order given by utils.ExtractScalarConstraints. The costs are in the sense of
+	the original objective and missing bounds are +/- symbolic.Infinity.
+	Rows and columns that presolve removes are marked inactive.
+*/
+type linearProgram struct {
+	costs             []float64
+	objectiveConstant float64
+	lower             []float64
+	upper             []float64
+	columnActive      []bool
+	rows              []linearRow
+}
+
+/*
+linearRow
+Description:
+
+	A row of a linearProgram. coeffs only contains nonzero coefficients.
+*/
+type linearRow struct {
+	coeffs map[int]float64
+	lower  float64
+	upper  float64
+	active bool
+}
+
+/*
+newLinearProgram
+Description:
+
+	Extracts the data of a linear problem.
+	Returns an error if the objective or one of the constraints is not linear.
+*/
+func newLinearProgram(prob *problem.OptimizationProblem) (*linearProgram, error) {
+	// Setup
+	variables := prob.Variables
+	lp := &linearProgram{
+		costs:        make([]float64, len(variables)),
+		lower:        make([]float64, len(variables)),
+		upper:        make([]float64, len(variables)),
+		columnActive: make([]bool, len(variables)),
+	}
+
+	// Columns
+	for jj, v := range variables {
+		lp.lower[jj], lp.upper[jj] = formats.ToBound(v.Lower), formats.ToBound(v.Upper)
+		lp.columnActive[jj] = true
+	}
+
+	// Objective
+	if prob.Objective.Expression != nil {
+		objective, ok := prob.Objective.Expression.(symbolic.ScalarExpression)
+		if !ok || !symbolic.IsLinear(objective) {
+			return nil, fmt.Errorf("the objective is not a linear scalar expression")
+		}
+		c := objective.LinearCoeff(variables)
+		for jj := range lp.costs {
+			lp.costs[jj] = c.AtVec(jj)
+		}
+		lp.objectiveConstant = objective.Constant()
+	}
+
+	// Rows
+	for ii, constraint := range utils.ExtractScalarConstraints(prob.Constraints) {
+		if !constraint.IsLinear() {
+			return nil, fmt.Errorf("constraint %v (%v) is not linear", ii, constraint)
+		}
+
+		expr := constraint.Left().Minus(constraint.Right()).(symbolic.ScalarExpression)
+		coeffs := expr.LinearCoeff(variables)
+		rhs := -expr.Constant()
+
+		row := linearRow{
+			coeffs: map[int]float64{},
+			lower:  -symbolic.Infinity.Constant(),
+			upper:  symbolic.Infinity.Constant(),
+			active: true,
+		}
+		for jj := 0; jj < coeffs.Len(); jj++ {
+			if coeffs.AtVec(jj) != 0 {
+				row.coeffs[jj] = coeffs.AtVec(jj)
+			}
+		}
+
+		switch constraint.ConstrSense() {
+		case symbolic.SenseLessThanEqual:
+			row.upper = rhs
+		case symbolic.SenseGreaterThanEqual:
+			row.lower = rhs
+		case symbolic.SenseEqual:
+			row.lower, row.upper = rhs, rhs
+		default:
+			return nil, fmt.Errorf("constraint %v has an unsupported sense (%v)", ii, constraint.ConstrSense())
+		}
+
+		lp.rows = append(lp.rows, row)
+	}
+
+	return lp, nil
+}
+
+/*
+clone
+Description:
+
+	Returns a deep copy of the linear program.
+*/
+func (lp *linearProgram) clone() *linearProgram {
+	out := &linearProgram{
+		costs:             append([]float64{}, lp.costs...),
+		objectiveConstant: lp.objectiveConstant,
+		lower:             append([]float64{}, lp.lower...),
+		upper:             append([]float64{}, lp.upper...),
+		columnActive:      append([]bool{}, lp.columnActive...),
+		rows:              make([]linearRow, len(lp.rows)),
+	}
+	for ii, row := range lp.rows {
+		out.rows[ii] = row
+		out.rows[ii].coeffs = copyCoefficients(row.coeffs)
+	}
+	return out
+}
+
+/*
+columnRows
+Description:
+
+	Returns the indices of the active rows in which each column appears.
+*/
+func (lp *linearProgram) columnRows() [][]int {
+	out := make([][]int, len(lp.costs))
+	for ii, row := range lp.rows {
+		if !row.active {
+			continue
+		}
+		for jj := range row.coeffs {
+			out[jj] = append(out[jj], ii)
+		}
+	}
+	return out
+}
+
+/*
+activityBounds
+Description:
+
+	Returns the smallest and the largest value that the row's activity can take
+	given the bounds of its columns (+/- symbolic.Infinity if it is unbounded).
+*/
+func (lp *linearProgram) activityBounds(row linearRow) (float64, float64) {
+	// Setup
+	minActivity, maxActivity := 0.0, 0.0
+	minIsInfinite, maxIsInfinite := false, false
+
+	for jj, a := range row.coeffs {
+		low, high := lp.lower[jj], lp.upper[jj]
+		if a < 0 {
+			low, high = high, low
+		}
+
+		if formats.IsFinite(low) {
+			minActivity += a * low
+		} else {
+			minIsInfinite = true
+		}
+		if formats.IsFinite(high) {
+			maxActivity += a * high
+		} else {
+			maxIsInfinite = true
+		}
+	}
+
+	if minIsInfinite {
+		minActivity = -symbolic.Infinity.Constant()
+	}
+	if maxIsInfinite {
+		maxActivity = symbolic.Infinity.Constant()
+	}
+	return minActivity, maxActivity
+}
+
+/*
+activity
+Description:
+
+	Returns sum_j coeffs[j] * x[j].
+*/
+func activity(coeffs map[int]float64, x []float64) float64 {
+	out := 0.0
+	for jj, a := range coeffs {
+		out += a * x[jj]
+	}
+	return out
+}
+
+/*
+copyCoefficients
+Description:
+
+	Returns a copy of a row's coefficients.
+*/
+func copyCoefficients(coeffs map[int]float64) map[int]float64 {
+	out := make(map[int]float64, len(coeffs))
+	for jj, a := range coeffs {
+		out[jj] = a
+	}
+	return out
+}
+
+/*
+divideBound
+Description:
+
+	Divides a bound by a, keeping infinite bounds infinite.
+*/
+func divideBound(bound, a float64) float64 {
+	if !formats.IsFinite(bound) {
+		return math.Copysign(symbolic.Infinity.Constant(), bound*a)
+	}
+	return bound / a
+}
+
+/*
+exceeds
+Description:
+
+	Returns true if a is larger than b by more than the presolve tolerance (relative to b).
+*/
+func exceeds(a, b float64) bool {
+	return a > b+Tolerance*(1+math.Abs(b))
+}
+
+/*
+isClose
+Description:
+
+	Returns true if a and b are equal up to the presolve tolerance.
+*/
+func isClose(a, b float64) bool {
+	return math.Abs(a-b) <= Tolerance*(1+math.Max(math.Abs(a), math.Abs(b)))
+}
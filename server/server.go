@@ -0,0 +1,304 @@
+/*
+Package server exposes the simplex solver over HTTP.
+
+	POST /solve		solves a problem given in the JSON encoding of the json_format package
+	GET  /healthz	reports that the server is running
+
+A request to /solve has the form
+
+	{
+	  "problem": { ... json_format.ProblemDocument ... },
+	  "time_limit_ms": 1000,
+	  "iteration_limit": 500,
+	  "algorithm": "tableau"
+	}
+
+and is answered with a json_format.SolutionDocument. The optional algorithm is one of
+"tableau" (the default), "exact-tableau" and "sparse-revised" (see algorithms.ToAlgorithmType);
+other names are rejected with 400 Bad Request. All three algorithms honour time_limit_ms:
+if the time limit is reached before the algorithm terminates, then the solution describes
+the last basis that was visited and has the status TIME_LIMIT. At most Config.Workers
+problems are solved at the same time; other requests wait for a free worker until their
+time limit has passed.
+*/
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"time"
+
+	"github.com/MatProGo-dev/simplex/algorithms"
+	json_format "github.com/MatProGo-dev/simplex/formats/json"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+)
+
+/*
+Config
+Description:
+
+	The limits enforced by the server. Zero values select the defaults
+	given in DefaultConfig.
+*/
+type Config struct {
+	// Workers is the maximum number of problems that are solved at the same time.
+	Workers int
+	// DefaultTimeLimit is used when a request does not specify a time limit.
+	DefaultTimeLimit time.Duration
+	// MaxTimeLimit is the largest time limit that a request may ask for.
+	MaxTimeLimit time.Duration
+	// DefaultIterationLimit is used when a request does not specify an iteration limit.
+	DefaultIterationLimit int
+	// MaxIterationLimit is the largest iteration limit that a request may ask for.
+	MaxIterationLimit int
+	// MaxRequestBytes is the largest request body that is accepted.
+	MaxRequestBytes int64
+}
+
+/*
+DefaultConfig
+Description:
+
+	Returns the default limits: one worker per CPU, 10 second (at most 1 minute)
+	time limits, 1000 (at most 100000) iterations and 10 MB request bodies.
+*/
+func DefaultConfig() Config {
+	return Config{
+		Workers:               runtime.NumCPU(),
+		DefaultTimeLimit:      10 * time.Second,
+		MaxTimeLimit:          time.Minute,
+		DefaultIterationLimit: 1000,
+		MaxIterationLimit:     100000,
+		MaxRequestBytes:       10 << 20,
+	}
+}
+
+/*
+SolveRequest
+Description:
+
+	The body of a request to /solve. TimeLimitMS and IterationLimit are optional
+	and are capped at the server's maximums. Algorithm is the optional name of the
+	algorithm type (the tableau algorithm if it is empty).
+*/
+type SolveRequest struct {
+	Problem        json_format.ProblemDocument `json:"problem"`
+	TimeLimitMS    int64                       `json:"time_limit_ms,omitempty"`
+	IterationLimit int                         `json:"iteration_limit,omitempty"`
+	Algorithm      string                      `json:"algorithm,omitempty"`
+}
+
+/*
+ErrorResponse
+Description:
+
+	The body of every response whose status code is not 200.
+*/
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+/*
+Server
+Description:
+
+	An http.Handler that serves /solve and /healthz.
+	Create it with New.
+*/
+type Server struct {
+	config  Config
+	workers chan struct{}
+	mux     *http.ServeMux
+}
+
+/*
+New
+Description:
+
+	Creates a server that enforces the given limits.
+*/
+func New(config Config) *Server {
+	// Input Processing
+	defaults := DefaultConfig()
+	if config.Workers <= 0 {
+		config.Workers = defaults.Workers
+	}
+	if config.DefaultTimeLimit <= 0 {
+		config.DefaultTimeLimit = defaults.DefaultTimeLimit
+	}
+	if config.MaxTimeLimit <= 0 {
+		config.MaxTimeLimit = defaults.MaxTimeLimit
+	}
+	if config.DefaultIterationLimit <= 0 {
+		config.DefaultIterationLimit = defaults.DefaultIterationLimit
+	}
+	if config.MaxIterationLimit <= 0 {
+		config.MaxIterationLimit = defaults.MaxIterationLimit
+	}
+	if config.MaxRequestBytes <= 0 {
+		config.MaxRequestBytes = defaults.MaxRequestBytes
+	}
+
+	// Create the routes
+	s := &Server{
+		config:  config,
+		workers: make(chan struct{}, config.Workers),
+		mux:     http.NewServeMux(),
+	}
+	s.mux.HandleFunc("/solve", s.handleSolve)
+	s.mux.HandleFunc("/healthz", s.handleHealthz)
+
+	return s
+}
+
+/*
+ListenAndServe
+Description:
+
+	Serves the solver with the given limits on the given address (e.g., "localhost:8080").
+*/
+func ListenAndServe(addr string, config Config) error {
+	return http.ListenAndServe(addr, New(config))
+}
+
+/*
+ServeHTTP
+Description:
+
+	Dispatches the request to /solve or /healthz.
+*/
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+/*
+handleHealthz
+Description:
+
+	Answers every GET request with {"status": "ok"}.
+*/
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("%v is not supported by /healthz", r.Method))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+/*
+handleSolve
+Description:
+
+	Decodes the problem, waits for a free worker and solves the problem within
+	the request's time and iteration limits.
+*/
+func (s *Server) handleSolve(w http.ResponseWriter, r *http.Request) {
+	// Input Processing
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("%v is not supported by /solve", r.Method))
+		return
+	}
+
+	var request SolveRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.config.MaxRequestBytes))
+	if err := decoder.Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("could not decode the request: %v", err))
+		return
+	}
+
+	model, err := request.Problem.ToModel()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	solver, err := s.solverFor(request)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// The time limit also covers the time spent waiting for a worker
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeLimitFor(request))
+	defer cancel()
+
+	select {
+	case s.workers <- struct{}{}:
+		defer func() { <-s.workers }()
+	case <-ctx.Done():
+		writeError(w, http.StatusServiceUnavailable, fmt.Errorf("no worker became available within the time limit"))
+		return
+	}
+
+	// Solve
+	sol, err := solver.SolveContext(ctx, *model.Problem)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	doc, err := json_format.NewSolutionDocument(&sol, model)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, doc)
+}
+
+/*
+solverFor
+Description:
+
+	Creates the solver for the request, capping its iteration limit.
+*/
+func (s *Server) solverFor(request SolveRequest) (simplexSolver.SimplexSolver, error) {
+	solver := simplexSolver.New("server")
+
+	solver.IterationLimit = s.config.DefaultIterationLimit
+	if request.IterationLimit > 0 {
+		solver.IterationLimit = min(request.IterationLimit, s.config.MaxIterationLimit)
+	}
+
+	if request.Algorithm != "" {
+		algoType, err := algorithms.ToAlgorithmType(request.Algorithm)
+		if err != nil {
+			return solver, err
+		}
+		solver.Algorithm = algoType
+	}
+
+	return solver, nil
+}
+
+/*
+timeLimitFor
+Description:
+
+	Returns the time limit of the request, capped at the server's maximum.
+	(The limit is compared in milliseconds, since a large TimeLimitMS would
+	overflow when converted into a time.Duration.)
+*/
+func (s *Server) timeLimitFor(request SolveRequest) time.Duration {
+	if request.TimeLimitMS <= 0 {
+		return s.config.DefaultTimeLimit
+	}
+	if request.TimeLimitMS >= s.config.MaxTimeLimit.Milliseconds() {
+		return s.config.MaxTimeLimit
+	}
+	return time.Duration(request.TimeLimitMS) * time.Millisecond
+}
+
+// writeJSON writes the value as the JSON body of the response.
+func writeJSON(w http.ResponseWriter, statusCode int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(value)
+}
+
+// writeError writes the error as an ErrorResponse.
+func writeError(w http.ResponseWriter, statusCode int, err error) {
+	writeJSON(w, statusCode, ErrorResponse{Error: err.Error()})
+}
@@ -0,0 +1,72 @@
+package simplexSolver
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+)
+
+/*
+BatchResult
+Description:
+
+	The result of solving one problem of a batch: the solution and the error
+	that Solve would have returned for the problem.
+*/
+type BatchResult struct {
+	Solution simplex_solution.SimplexSolution
+	Err      error
+}
+
+/*
+SolveBatch
+Description:
+
+	Solves independent problems in parallel using solver.Workers workers and returns
+	one result per problem, in the order of the input. An error while solving one
+	problem does not affect the others.
+
+	Every solve creates its own algorithm, standard form problem (whose slack variables
+	belong to that problem rather than to symbolic.DefaultEnvironment), tableau and
+	history, and the input problems are only read, so the same problem may appear more
+	than once. A custom SelectionRule is shared by all workers and must therefore be
+	safe for concurrent use (BlandsRule is).
+
+	Once ctx is done, the running solves stop early (see SolveContext) and the
+	problems that have not been started get ctx's error.
+*/
+func (solver *SimplexSolver) SolveBatch(ctx context.Context, problems []problem.OptimizationProblem) []BatchResult {
+	// Setup
+	results := make([]BatchResult, len(problems))
+	nWorkers := solver.Workers
+	if nWorkers <= 0 {
+		nWorkers = runtime.NumCPU()
+	}
+	nWorkers = min(nWorkers, len(problems))
+
+	// Start the workers
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for ii := 0; ii < nWorkers; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				sol, err := solver.SolveContext(ctx, problems[idx])
+				results[idx] = BatchResult{Solution: sol, Err: err}
+			}
+		}()
+	}
+
+	// Hand out the problems
+	for idx := range problems {
+		jobs <- idx
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
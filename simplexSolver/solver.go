@@ -1,18 +1,55 @@
 package simplexSolver
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
 	"github.com/MatProGo-dev/simplex/algorithms"
+	revised_algorithm "github.com/MatProGo-dev/simplex/algorithms/revised"
 	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/algorithms/tableau/selection"
+	"github.com/MatProGo-dev/simplex/presolve"
 	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils"
 )
 
 type SimplexSolver struct {
 	Name           string
 	IterationLimit int
 	Algorithm      algorithms.AlgorithmType
+	// SelectionRule picks the pivots of the tableau algorithm (Bland's Rule if nil).
+	SelectionRule selection.SelectionRule
+	// PivotTolerance and OptimalityTolerance are passed on to the algorithm
+	// (zero selects the algorithm's defaults).
+	PivotTolerance      float64
+	OptimalityTolerance float64
+	// RefactorizationFrequency and ResidualTolerance control how often the tableau is
+	// recomputed from the problem (see tableau_algorithm1.TableauAlgorithm.RefactorizationFrequency).
+	RefactorizationFrequency int
+	ResidualTolerance        float64
+	// InPlacePivoting makes the tableau algorithm pivot its tableau in place
+	// (see tableau_algorithm1.TableauAlgorithm.InPlacePivoting).
+	InPlacePivoting bool
+	// Scaling is the method used to scale the problem before it is solved
+	// (see tableau_algorithm1.TableauAlgorithm.Scaling).
+	Scaling tableau_algorithm1.ScalingMethod
+	// InitialBasis is an optional basis from which the solve starts (see
+	// tableau_algorithm1.TableauAlgorithm.InitialBasis).
+	InitialBasis *simplex_solution.Basis
+	// Presolve reduces the problem before the algorithm is run and maps the solution back
+	// to the original problem (see the presolve package). InitialBasis is ignored when
+	// Presolve is set because it does not describe the reduced problem.
+	Presolve bool
+	// VerificationTolerance, if positive, makes every solve verify its solution against
+	// the problem and downgrade an OPTIMAL status whose violations exceed the tolerance
+	// (see simplex_solution.SimplexSolution.VerifyAndDowngrade).
+	VerificationTolerance float64
+	// Workers is the number of problems that SolveBatch solves at the same time
+	// (runtime.NumCPU() if it is not positive).
+	Workers int
 }
 
 func New(name string) SimplexSolver {
@@ -25,13 +62,36 @@ func New(name string) SimplexSolver {
 
 func (solver *SimplexSolver) CreateAlgorithm(algoType algorithms.AlgorithmType) (algorithms.AlgorithmInterface, error) {
 	// Setup
+	initialBasis := solver.InitialBasis
+	if solver.Presolve {
+		initialBasis = nil
+	}
 
 	// Selection Logic
 	switch algoType {
 	case algorithms.TypeNaiveTableau:
 		return &tableau_algorithm1.TableauAlgorithm{
+			IterationLimit:           solver.IterationLimit,
+			SelectionRule:            solver.SelectionRule,
+			PivotTolerance:           solver.PivotTolerance,
+			OptimalityTolerance:      solver.OptimalityTolerance,
+			Scaling:                  solver.Scaling,
+			InitialBasis:             initialBasis,
+			RefactorizationFrequency: solver.RefactorizationFrequency,
+			ResidualTolerance:        solver.ResidualTolerance,
+			InPlacePivoting:          solver.InPlacePivoting,
+		}, nil
+	case algorithms.TypeExactTableau:
+		return &tableau_algorithm1.RationalTableauAlgorithm{
 			IterationLimit: solver.IterationLimit,
 		}, nil
+	case algorithms.TypeSparseRevised:
+		return &revised_algorithm.RevisedSimplexAlgorithm{
+			IterationLimit:           solver.IterationLimit,
+			PivotTolerance:           solver.PivotTolerance,
+			OptimalityTolerance:      solver.OptimalityTolerance,
+			RefactorizationFrequency: solver.RefactorizationFrequency,
+		}, nil
 	default:
 		return &tableau_algorithm1.TableauAlgorithm{}, fmt.Errorf(
 			"The Solve() function was given an unknown solver type: %v",
@@ -42,6 +102,7 @@ func (solver *SimplexSolver) CreateAlgorithm(algoType algorithms.AlgorithmType)
 
 func (solver *SimplexSolver) Solve(prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
 	// Setup
+	started := time.Now()
 
 	// Choose Algorithm
 	algo, err := solver.CreateAlgorithm(solver.Algorithm)
@@ -53,6 +114,159 @@ func (solver *SimplexSolver) Solve(prob problem.OptimizationProblem) (simplex_so
 	}
 
 	// Apply algorithm
-	return algo.Solve(prob)
+	var sol simplex_solution.SimplexSolution
+	if solver.Presolve {
+		sol, err = solvePresolved(prob, algo.Solve)
+	} else {
+		sol, err = algo.Solve(prob)
+	}
+	return solver.finish(started, sol, err)
+
+}
+
+/*
+SolveWithHistory
+Description:
+
+	Solves the problem like Solve with the tableau algorithm and also returns every
+	state that it visited (see tableau_algorithm1.TableauAlgorithm.SolveWithHistory).
+	InPlacePivoting is ignored because its states share one tableau. If Presolve is set,
+	the history describes the reduced problem (and is empty if presolve decided the
+	problem). Returns an error if Algorithm is not the tableau algorithm.
+*/
+func (solver *SimplexSolver) SolveWithHistory(prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, []tableau_algorithm1.TableauAlgorithmState, error) {
+	// Setup
+	started := time.Now()
+
+	// Choose Algorithm
+	algo, err := solver.CreateAlgorithm(solver.Algorithm)
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, nil, err
+	}
+	tableauAlgo, ok := algo.(*tableau_algorithm1.TableauAlgorithm)
+	if !ok {
+		return simplex_solution.SimplexSolution{}, nil, fmt.Errorf(
+			"SolveWithHistory: the history is only recorded by the tableau algorithm, not by %v",
+			solver.Algorithm,
+		)
+	}
+	tableauAlgo.InPlacePivoting = false
+
+	// Apply algorithm
+	var history []tableau_algorithm1.TableauAlgorithmState
+	solve := func(prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
+		var sol simplex_solution.SimplexSolution
+		sol, history, err = tableauAlgo.SolveWithHistory(prob)
+		return sol, err
+	}
+	var sol simplex_solution.SimplexSolution
+	if solver.Presolve {
+		sol, err = solvePresolved(prob, solve)
+	} else {
+		sol, err = solve(prob)
+	}
+	sol, err = solver.finish(started, sol, err)
+	return sol, history, err
+}
+
+/*
+SolveContext
+Description:
+
+	Solves the problem like Solve, but stops early once ctx is done if the
+	chosen algorithm supports it (see algorithms.ContextAlgorithmInterface).
+	Returns ctx's error if ctx is already done before the solve starts.
+*/
+func (solver *SimplexSolver) SolveContext(ctx context.Context, prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
+	// Input Processing
+	if err := ctx.Err(); err != nil {
+		return simplex_solution.SimplexSolution{}, err
+	}
+	started := time.Now()
+
+	// Choose Algorithm
+	algo, err := solver.CreateAlgorithm(solver.Algorithm)
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, err
+	}
+
+	// Apply algorithm
+	solve := algo.Solve
+	if contextAlgo, ok := algo.(algorithms.ContextAlgorithmInterface); ok {
+		solve = func(prob problem.OptimizationProblem) (simplex_solution.SimplexSolution, error) {
+			return contextAlgo.SolveContext(ctx, prob)
+		}
+	}
+	var sol simplex_solution.SimplexSolution
+	if solver.Presolve {
+		sol, err = solvePresolved(prob, solve)
+	} else {
+		sol, err = solve(prob)
+	}
+	return solver.finish(started, sol, err)
+}
+
+/*
+finish
+Description:
+
+	Completes the solution of a solve that started at the given time: verifies it (if
+	VerificationTolerance is positive and the solve succeeded with variable values),
+	downgrades its status if needed and records the total time of the solve
+	(including presolve and verification).
+*/
+func (solver *SimplexSolver) finish(started time.Time, sol simplex_solution.SimplexSolution, err error) (simplex_solution.SimplexSolution, error) {
+	if err != nil {
+		return sol, err
+	}
+
+	if solver.VerificationTolerance > 0 && sol.VariableValues != nil && sol.OriginalProblem != nil {
+		_, err = sol.VerifyAndDowngrade(solver.VerificationTolerance)
+		if err != nil {
+			return sol, fmt.Errorf("the solution could not be verified: %v", err)
+		}
+	}
+
+	sol.Statistics.TotalTime = time.Since(started)
+	return sol, nil
+}
+
+/*
+solvePresolved
+Description:
+
+	Presolves the problem, solves the reduced problem with solve (unless presolve
+	already decided the problem) and maps the solution back to the original problem.
+	Returns an error if the reduced problem keeps an equality constraint, which the
+	algorithms cannot start from their slack basis.
+*/
+func solvePresolved(
+	prob problem.OptimizationProblem,
+	solve func(problem.OptimizationProblem) (simplex_solution.SimplexSolution, error),
+) (simplex_solution.SimplexSolution, error) {
+	// Presolve
+	result, err := presolve.Presolve(&prob)
+	if err != nil {
+		return simplex_solution.SimplexSolution{}, err
+	}
+
+	// Solve the reduced problem
+	var reduced simplex_solution.SimplexSolution
+	if result.Problem != nil {
+		for _, constraint := range utils.ExtractScalarConstraints(result.Problem.Constraints) {
+			if constraint.ConstrSense() == symbolic.SenseEqual {
+				return simplex_solution.SimplexSolution{}, fmt.Errorf(
+					"presolve could not remove the equality constraint %v (equality constraints are not supported)",
+					constraint,
+				)
+			}
+		}
+
+		reduced, err = solve(*result.Problem)
+		if err != nil {
+			return reduced, err
+		}
+	}
 
+	return result.Postsolve(reduced)
 }
@@ -0,0 +1,87 @@
+package simplex_solution
+
+import "fmt"
+
+/*
+BasisStatus
+Description:
+
+	The status of a variable or of a constraint's slack in a basis.
+	- BasisStatusBasic: the variable (or slack) is basic.
+	- BasisStatusAtLower: the variable is nonbasic at its lower bound
+	  (for a constraint: its activity is at its lower limit).
+	- BasisStatusAtUpper: the variable is nonbasic at its upper bound
+	  (for a constraint: its activity is at its upper limit).
+	- BasisStatusFree: the variable is free and nonbasic (at zero).
+*/
+type BasisStatus int
+
+const (
+	BasisStatusBasic BasisStatus = iota
+	BasisStatusAtLower
+	BasisStatusAtUpper
+	BasisStatusFree
+)
+
+/*
+String
+Description:
+
+	Returns the name of the status (as used by the JSON encoding).
+*/
+func (bs BasisStatus) String() string {
+	switch bs {
+	case BasisStatusBasic:
+		return "basic"
+	case BasisStatusAtLower:
+		return "at_lower"
+	case BasisStatusAtUpper:
+		return "at_upper"
+	case BasisStatusFree:
+		return "free"
+	}
+	return fmt.Sprintf("BasisStatus(%d)", int(bs))
+}
+
+/*
+ToBasisStatus
+Description:
+
+	Converts the name of a status (see String) back into a BasisStatus.
+*/
+func ToBasisStatus(name string) (BasisStatus, error) {
+	for _, bs := range []BasisStatus{BasisStatusBasic, BasisStatusAtLower, BasisStatusAtUpper, BasisStatusFree} {
+		if bs.String() == name {
+			return bs, nil
+		}
+	}
+	return BasisStatusBasic, fmt.Errorf("unknown basis status \"%v\"", name)
+}
+
+/*
+Basis
+Description:
+
+	Describes a basis of a linear program in terms of its original variables and constraints.
+	VariableStatus maps variable IDs to their statuses and ConstraintStatus contains the status
+	of each scalar constraint (in the order given by utils.ExtractScalarConstraints).
+*/
+type Basis struct {
+	VariableStatus   map[uint64]BasisStatus
+	ConstraintStatus []BasisStatus
+}
+
+/*
+NewBasisFromBasicVariables
+Description:
+
+	Creates a basis in which the variables with the given IDs are basic.
+	The statuses of the constraints are left unspecified.
+*/
+func NewBasisFromBasicVariables(ids ...uint64) *Basis {
+	basis := &Basis{VariableStatus: map[uint64]BasisStatus{}}
+	for _, id := range ids {
+		basis.VariableStatus[id] = BasisStatusBasic
+	}
+	return basis
+}
@@ -0,0 +1,68 @@
+package simplex_solution
+
+import (
+	"math/big"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+)
+
+// RationalSolution is the result of solving a linear program with exact (rational) arithmetic.
+// The variable values and the objective value are exact fractions.
+type RationalSolution struct {
+	// VariableValues maps variable IDs to their exact solution values.
+	VariableValues map[uint64]*big.Rat
+	// Objective is the exact value of the original objective at VariableValues.
+	// It is nil if the variable values are not available.
+	Objective *big.Rat
+	// Status indicates the status of the solution (e.g., optimal, unbounded).
+	Status     solution_status.SolutionStatus
+	Iterations int
+	// Statistics describes how the solution was obtained.
+	Statistics Statistics
+	// OriginalProblem is the optimization problem that was solved to obtain this solution.
+	OriginalProblem *problem.OptimizationProblem
+}
+
+/*
+Float64Values
+Description:
+
+	Returns the variable values rounded to the nearest float64.
+*/
+func (sol *RationalSolution) Float64Values() map[uint64]float64 {
+	if sol.VariableValues == nil {
+		return nil
+	}
+
+	out := map[uint64]float64{}
+	for id, value := range sol.VariableValues {
+		out[id], _ = value.Float64()
+	}
+	return out
+}
+
+/*
+ToSimplexSolution
+Description:
+
+	Converts the solution into a SimplexSolution whose values (and objective) are rounded to the
+	nearest float64.
+*/
+func (sol *RationalSolution) ToSimplexSolution() SimplexSolution {
+	out := SimplexSolution{
+		VariableValues:  sol.Float64Values(),
+		Status:          sol.Status,
+		Iterations:      sol.Iterations,
+		Statistics:      sol.Statistics,
+		OriginalProblem: sol.OriginalProblem,
+	}
+
+	// The activities are computed from the rounded values (and are left out if that fails)
+	// and the objective is the rounded exact objective
+	_ = out.EvaluateAtVariableValues()
+	if sol.Objective != nil {
+		out.Objective, _ = sol.Objective.Float64()
+	}
+	return out
+}
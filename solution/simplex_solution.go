@@ -1,9 +1,12 @@
 package simplex_solution
 
 import (
+	"fmt"
+
 	"github.com/MatProGo-dev/MatProInterface.go/problem"
-	"github.com/MatProGo-dev/MatProInterface.go/solution"
 	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/utils"
 )
 
 // SimplexSolution represents the result of solving a linear program using the simplex method.
@@ -12,9 +15,37 @@ type SimplexSolution struct {
 	// VariableValues maps variable IDs (as uint64) to their solution values.
 	// The uint64 key typically represents the unique identifier or index of a variable in the model.
 	VariableValues map[uint64]float64
+	// Objective is the value of the original objective (including its constant term) at VariableValues.
+	Objective float64
 	// Status indicates the status of the solution (e.g., optimal, infeasible).
 	Status     solution_status.SolutionStatus
 	Iterations int
+	// DualValues contains the dual value (shadow price) of each scalar constraint of OriginalProblem,
+	// in the order given by utils.ExtractScalarConstraints. It is the rate of change of the objective
+	// with respect to the constraint's right hand side. It is nil if the duals could not be computed.
+	DualValues []float64
+	// ReducedCosts maps variable IDs to their reduced costs (c_j - y^T A_j in the sense of the original
+	// objective). It is nil if the duals could not be computed.
+	ReducedCosts map[uint64]float64
+	// Basis describes the final basis in terms of the original variables and of the scalar
+	// constraints of OriginalProblem. It can be passed to a later solve as its initial basis.
+	// It is nil if the algorithm does not report a basis (only the tableau algorithm does) or if the
+	// basis could not be described, in which case Warnings gives the reason.
+	Basis *Basis
+	// ConstraintActivities contains the activity a_k^T x of each scalar constraint of OriginalProblem
+	// (in the order given by utils.ExtractScalarConstraints), where the constraint is written as
+	// a_k^T x (sense) b_k with all of its variables on the left and all of its constants on the right.
+	ConstraintActivities []float64
+	// ConstraintSlacks contains b_k - a_k^T x for <= and == constraints and a_k^T x - b_k for >=
+	// constraints (in the order of ConstraintActivities), so that the slack of a satisfied
+	// inequality is nonnegative.
+	ConstraintSlacks []float64
+	// Statistics describes how the solution was obtained (e.g., the reductions made by presolve).
+	Statistics Statistics
+	// Warnings describes problems that were found while the solution was computed (e.g., a
+	// refactorization of the tableau that changed the basic solution or a final basis that could
+	// not be described). It is nil if there were none.
+	Warnings []string
 	// originalProblem is the original optimization problem that was solved to obtain this solution.
 	// It is included for reference and may be nil if not applicable.
 	OriginalProblem *problem.OptimizationProblem
@@ -24,13 +55,75 @@ func (sol *SimplexSolution) GetValueMap() map[uint64]float64 {
 	return sol.VariableValues
 }
 
+/*
+GetOptimalValue
+Description:
+
+	Returns the objective value stored in the solution (see Objective).
+*/
 func (sol *SimplexSolution) GetOptimalValue() float64 {
-	// Use the symbolic.Solution interface to compute the optimal value
-	optVal, err := solution.GetOptimalObjectiveValue(sol)
-	if err != nil {
-		return 0.0
+	return sol.Objective
+}
+
+/*
+EvaluateAtVariableValues
+Description:
+
+	Computes Objective, ConstraintActivities and ConstraintSlacks from OriginalProblem
+	(which must be a linear program) and VariableValues. Variables without a value are
+	treated as zero. If the solution has no variable values, then the objective is zero
+	and there are no activities or slacks.
+*/
+func (sol *SimplexSolution) EvaluateAtVariableValues() error {
+	// Input Processing
+	sol.Objective, sol.ConstraintActivities, sol.ConstraintSlacks = 0.0, nil, nil
+	if sol.VariableValues == nil {
+		return nil
+	}
+
+	prob := sol.OriginalProblem
+	if prob == nil {
+		return fmt.Errorf("EvaluateAtVariableValues: the solution has no original problem")
+	}
+
+	objective, ok := prob.Objective.Expression.(symbolic.ScalarExpression)
+	if !ok || !symbolic.IsLinear(objective) {
+		return fmt.Errorf("EvaluateAtVariableValues: the objective is not a linear scalar expression")
+	}
+
+	// Setup (only the variables of each expression are visited, so that sparse problems stay cheap)
+	activityOf := func(expr symbolic.ScalarExpression) float64 {
+		variables, coeffs := utils.SparseLinearCoeff(expr)
+		out := 0.0
+		for jj, v := range variables {
+			out += coeffs[jj] * sol.VariableValues[v.ID]
+		}
+		return out
+	}
+
+	// Objective
+	objectiveValue := activityOf(objective) + objective.Constant()
+
+	// Constraints
+	constraints := utils.ExtractScalarConstraints(prob.Constraints)
+	activities := make([]float64, len(constraints))
+	slacks := make([]float64, len(constraints))
+	for kk, constraint := range constraints {
+		expr, ok := constraint.Left().Minus(constraint.Right()).(symbolic.ScalarExpression)
+		if !ok || !symbolic.IsLinear(expr) {
+			return fmt.Errorf("EvaluateAtVariableValues: the constraint %v is not linear", kk)
+		}
+
+		activities[kk] = activityOf(expr)
+		rhs := -expr.Constant()
+		slacks[kk] = rhs - activities[kk]
+		if constraint.ConstrSense() == symbolic.SenseGreaterThanEqual {
+			slacks[kk] = -slacks[kk]
+		}
 	}
-	return optVal
+
+	sol.Objective, sol.ConstraintActivities, sol.ConstraintSlacks = objectiveValue, activities, slacks
+	return nil
 }
 
 func (sol *SimplexSolution) GetStatus() solution_status.SolutionStatus {
@@ -0,0 +1,166 @@
+package simplex_solution
+
+import "time"
+
+// The names of the phases in Statistics.Phases.
+const (
+	// PhaseWarmStart contains the pivots that move the initial tableau to a user-provided basis.
+	PhaseWarmStart = "warm start"
+	// PhasePrimalSimplex contains the pivots chosen by the primal simplex method.
+	PhasePrimalSimplex = "primal simplex"
+	// PhaseDualSimplex contains the pivots chosen by the dual simplex method (after modifications).
+	PhaseDualSimplex = "dual simplex"
+	// PhaseManual contains the pivots chosen by the user.
+	PhaseManual = "manual"
+)
+
+/*
+Statistics
+Description:
+
+	Information about how a solution was obtained.
+	- Presolve: the reductions made by presolve (nil if the problem was not presolved).
+	- Verification: the verification report (nil if the solution was not verified, see VerifyAndDowngrade).
+	- TotalTime: the wall time of the whole solve.
+	- Phases: the wall time and the number of pivots of each phase, in the order in which
+	  the phases first occurred.
+	- Refactorizations: the number of times the tableau was recomputed from the original problem.
+	- BasisConditionEstimate: an estimate of the (1-norm) condition number of the final basis matrix
+	  (0 if it was not computed).
+	- MaxEntryGrowth: the largest magnitude of a tableau entry during the solve divided by the
+	  largest magnitude of an entry of the initial tableau (0 if it was not computed).
+*/
+type Statistics struct {
+	Presolve               *PresolveStatistics
+	Verification           *VerificationReport
+	TotalTime              time.Duration
+	Phases                 []PhaseStatistics
+	Refactorizations       int
+	BasisConditionEstimate float64
+	MaxEntryGrowth         float64
+}
+
+/*
+PhaseStatistics
+Description:
+
+	The wall time spent in a phase of the algorithm, the number of pivots that the phase made
+	and the number of those pivots that were degenerate (did not change the objective value).
+*/
+type PhaseStatistics struct {
+	Name             string
+	Time             time.Duration
+	Pivots           int
+	DegeneratePivots int
+}
+
+/*
+Phase
+Description:
+
+	Returns the statistics of the phase with the given name (or nil if the phase did not occur).
+*/
+func (stats *Statistics) Phase(name string) *PhaseStatistics {
+	for ii := range stats.Phases {
+		if stats.Phases[ii].Name == name {
+			return &stats.Phases[ii]
+		}
+	}
+	return nil
+}
+
+/*
+RecordPivots
+Description:
+
+	Adds pivots (of which degeneratePivots were degenerate) and the time that they took
+	to the statistics of the phase with the given name.
+*/
+func (stats *Statistics) RecordPivots(phase string, pivots int, degeneratePivots int, elapsed time.Duration) {
+	phaseStats := stats.Phase(phase)
+	if phaseStats == nil {
+		stats.Phases = append(stats.Phases, PhaseStatistics{Name: phase})
+		phaseStats = &stats.Phases[len(stats.Phases)-1]
+	}
+
+	phaseStats.Time += elapsed
+	phaseStats.Pivots += pivots
+	phaseStats.DegeneratePivots += degeneratePivots
+}
+
+/*
+Pivots
+Description:
+
+	Returns the number of pivots made in all phases.
+*/
+func (stats *Statistics) Pivots() int {
+	out := 0
+	for _, phase := range stats.Phases {
+		out += phase.Pivots
+	}
+	return out
+}
+
+/*
+DegeneratePivots
+Description:
+
+	Returns the number of degenerate pivots made in all phases.
+*/
+func (stats *Statistics) DegeneratePivots() int {
+	out := 0
+	for _, phase := range stats.Phases {
+		out += phase.DegeneratePivots
+	}
+	return out
+}
+
+/*
+PresolveStatistics
+Description:
+
+	The size of a problem before and after presolve (counting scalar constraints and
+	variables) and the number of reductions of each kind that presolve made.
+	- EmptyRows: constraints without variables that were removed.
+	- SingletonRows: constraints on a single variable that were turned into bounds.
+	- RedundantRows: constraints that are implied by the bounds of their variables.
+	- DuplicateRows: constraints that are a multiple of another constraint and were merged into it.
+	- EmptyColumns: variables that appear in no constraint and were fixed at their best bound.
+	- FixedColumns: variables whose lower and upper bounds are equal.
+	- SingletonColumns: free variables that appear in a single constraint and were eliminated
+	  together with it.
+*/
+type PresolveStatistics struct {
+	RowsBefore       int
+	ColumnsBefore    int
+	RowsAfter        int
+	ColumnsAfter     int
+	EmptyRows        int
+	SingletonRows    int
+	RedundantRows    int
+	DuplicateRows    int
+	EmptyColumns     int
+	FixedColumns     int
+	SingletonColumns int
+}
+
+/*
+RowsRemoved
+Description:
+
+	Returns the number of constraints that presolve removed.
+*/
+func (stats PresolveStatistics) RowsRemoved() int {
+	return stats.RowsBefore - stats.RowsAfter
+}
+
+/*
+ColumnsRemoved
+Description:
+
+	Returns the number of variables that presolve removed.
+*/
+func (stats PresolveStatistics) ColumnsRemoved() int {
+	return stats.ColumnsBefore - stats.ColumnsAfter
+}
@@ -0,0 +1,267 @@
+package simplex_solution
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+VerificationReport
+Description:
+
+	How far a solution is from satisfying the optimality (KKT) conditions of its
+	original problem. All violations are absolute and zero means "satisfied".
+	- MaxPrimalViolation: the largest violation of a scalar constraint (in the order given
+	  by utils.ExtractScalarConstraints); WorstConstraint is its index (-1 if none is violated).
+	- MaxBoundViolation: the largest violation of a variable's lower or upper bound;
+	  WorstBoundVariable is the ID of that variable.
+	- MaxDualViolation: the largest amount by which a dual value or a reduced cost has
+	  the wrong sign for its constraint or variable.
+	- MaxStationarityViolation: the largest difference between a reported reduced cost
+	  and c_j - sum_k y_k a_kj.
+	- MaxComplementarityViolation: the largest product of a dual value (or reduced cost)
+	  and the distance of its constraint (or variable) from the bound it is tied to.
+	- PrimalObjective, DualObjective: the objective values of the primal and the dual solution.
+	  ObjectiveGap is their absolute difference and RelativeObjectiveGap is
+	  ObjectiveGap / (1 + |PrimalObjective|).
+	DualsChecked is false if the solution has no dual values, in which case only the
+	primal measures are computed.
+*/
+type VerificationReport struct {
+	MaxPrimalViolation          float64
+	WorstConstraint             int
+	MaxBoundViolation           float64
+	WorstBoundVariable          uint64
+	DualsChecked                bool
+	MaxDualViolation            float64
+	MaxStationarityViolation    float64
+	MaxComplementarityViolation float64
+	PrimalObjective             float64
+	DualObjective               float64
+	ObjectiveGap                float64
+	RelativeObjectiveGap        float64
+}
+
+/*
+PrimalFeasibleWithin
+Description:
+
+	Returns true if no constraint or bound is violated by more than tolerance.
+*/
+func (report VerificationReport) PrimalFeasibleWithin(tolerance float64) bool {
+	return report.MaxPrimalViolation <= tolerance && report.MaxBoundViolation <= tolerance
+}
+
+/*
+OptimalWithin
+Description:
+
+	Returns true if the solution is primal feasible within tolerance and (if the duals
+	were checked) the dual, stationarity and complementarity violations and the
+	relative objective gap are at most tolerance.
+*/
+func (report VerificationReport) OptimalWithin(tolerance float64) bool {
+	if !report.PrimalFeasibleWithin(tolerance) {
+		return false
+	}
+	if !report.DualsChecked {
+		return true
+	}
+	return report.MaxDualViolation <= tolerance &&
+		report.MaxStationarityViolation <= tolerance &&
+		report.MaxComplementarityViolation <= tolerance &&
+		report.RelativeObjectiveGap <= tolerance
+}
+
+/*
+Verify
+Description:
+
+	Checks the solution against its OriginalProblem (which must be a linear program)
+	and reports the violations of the optimality conditions. Variables without a value
+	are treated as zero.
+*/
+func (sol *SimplexSolution) Verify() (VerificationReport, error) {
+	// Input Processing
+	prob := sol.OriginalProblem
+	if prob == nil {
+		return VerificationReport{}, fmt.Errorf("Verify: the solution has no original problem")
+	}
+	if sol.VariableValues == nil {
+		return VerificationReport{}, fmt.Errorf("Verify: the solution has no variable values")
+	}
+
+	objective, ok := prob.Objective.Expression.(symbolic.ScalarExpression)
+	if !ok || !symbolic.IsLinear(objective) {
+		return VerificationReport{}, fmt.Errorf("Verify: the objective is not a linear scalar expression")
+	}
+
+	constraints := utils.ExtractScalarConstraints(prob.Constraints)
+	if sol.DualValues != nil && len(sol.DualValues) != len(constraints) {
+		return VerificationReport{}, fmt.Errorf(
+			"Verify: expected %v dual values, but the solution has %v",
+			len(constraints),
+			len(sol.DualValues),
+		)
+	}
+
+	// Setup
+	report := VerificationReport{WorstConstraint: -1, DualsChecked: sol.DualValues != nil}
+	x := make([]float64, len(prob.Variables))
+	for jj, v := range prob.Variables {
+		x[jj] = sol.VariableValues[v.ID]
+	}
+
+	// The sign of the objective in the minimization form of the problem
+	sense := 1.0
+	if prob.Objective.Sense == problem.SenseMaximize {
+		sense = -1.0
+	}
+
+	// Objective
+	c := objective.LinearCoeff(prob.Variables)
+	report.PrimalObjective = objective.Constant()
+	for jj := range x {
+		report.PrimalObjective += c.AtVec(jj) * x[jj]
+	}
+	report.DualObjective = objective.Constant()
+
+	// Constraints (written as a_k^T x + a0_k (sense) 0)
+	reducedCosts := make([]float64, len(x))
+	for jj := range x {
+		reducedCosts[jj] = c.AtVec(jj)
+	}
+
+	for kk, constraint := range constraints {
+		expr, ok := constraint.Left().Minus(constraint.Right()).(symbolic.ScalarExpression)
+		if !ok || !symbolic.IsLinear(expr) {
+			return VerificationReport{}, fmt.Errorf("Verify: the constraint %v is not linear", kk)
+		}
+		a := expr.LinearCoeff(prob.Variables)
+		value := expr.Constant()
+		for jj := range x {
+			value += a.AtVec(jj) * x[jj]
+		}
+
+		// Primal feasibility
+		var violation float64
+		switch constraint.ConstrSense() {
+		case symbolic.SenseLessThanEqual:
+			violation = max(value, 0)
+		case symbolic.SenseGreaterThanEqual:
+			violation = max(-value, 0)
+		default:
+			violation = math.Abs(value)
+		}
+		if violation > report.MaxPrimalViolation {
+			report.MaxPrimalViolation, report.WorstConstraint = violation, kk
+		}
+
+		if !report.DualsChecked {
+			continue
+		}
+
+		// Dual feasibility (in the minimization form, <= rows have nonpositive duals
+		// and >= rows nonnegative duals) and complementary slackness
+		y := sol.DualValues[kk]
+		switch constraint.ConstrSense() {
+		case symbolic.SenseLessThanEqual:
+			report.MaxDualViolation = max(report.MaxDualViolation, sense*y)
+		case symbolic.SenseGreaterThanEqual:
+			report.MaxDualViolation = max(report.MaxDualViolation, -sense*y)
+		}
+		if constraint.ConstrSense() != symbolic.SenseEqual {
+			report.MaxComplementarityViolation = max(report.MaxComplementarityViolation, math.Abs(y*value))
+		}
+
+		report.DualObjective -= y * expr.Constant()
+		for jj := range x {
+			reducedCosts[jj] -= y * a.AtVec(jj)
+		}
+	}
+
+	// Variables
+	for jj, v := range prob.Variables {
+		// Bounds
+		lower, upper := v.Lower, v.Upper
+		if violation := max(lower-x[jj], x[jj]-upper, 0); violation > report.MaxBoundViolation {
+			report.MaxBoundViolation, report.WorstBoundVariable = violation, v.ID
+		}
+
+		if !report.DualsChecked {
+			continue
+		}
+
+		// Stationarity
+		d := reducedCosts[jj]
+		if sol.ReducedCosts != nil {
+			reported := sol.ReducedCosts[v.ID]
+			report.MaxStationarityViolation = max(report.MaxStationarityViolation, math.Abs(reported-d))
+			d = reported
+		}
+
+		// Dual feasibility and complementary slackness (in the minimization form, a positive
+		// reduced cost ties the variable to its lower bound and a negative one to its upper bound)
+		bound := x[jj]
+		switch dMin := sense * d; {
+		case dMin > 0:
+			if formats.IsFinite(lower) {
+				bound = lower
+			} else {
+				report.MaxDualViolation = max(report.MaxDualViolation, dMin)
+			}
+		case dMin < 0:
+			if formats.IsFinite(upper) {
+				bound = upper
+			} else {
+				report.MaxDualViolation = max(report.MaxDualViolation, -dMin)
+			}
+		}
+		report.MaxComplementarityViolation = max(report.MaxComplementarityViolation, math.Abs(d*(x[jj]-bound)))
+		report.DualObjective += d * bound
+	}
+
+	// Objective gap
+	if report.DualsChecked {
+		report.ObjectiveGap = math.Abs(report.PrimalObjective - report.DualObjective)
+		report.RelativeObjectiveGap = report.ObjectiveGap / (1 + math.Abs(report.PrimalObjective))
+	} else {
+		report.DualObjective = math.NaN()
+	}
+
+	return report, nil
+}
+
+/*
+VerifyAndDowngrade
+Description:
+
+	Verifies the solution (see Verify) and, if its status is OPTIMAL but the report
+	exceeds tolerance, downgrades the status: to NUMERIC if the solution is not primal
+	feasible within tolerance and to SUBOPTIMAL otherwise. The report is also stored
+	in the solution's statistics.
+*/
+func (sol *SimplexSolution) VerifyAndDowngrade(tolerance float64) (VerificationReport, error) {
+	// Verify
+	report, err := sol.Verify()
+	if err != nil {
+		return report, err
+	}
+	sol.Statistics.Verification = &report
+
+	// Downgrade
+	if sol.Status == solution_status.OPTIMAL && !report.OptimalWithin(tolerance) {
+		sol.Status = solution_status.SUBOPTIMAL
+		if !report.PrimalFeasibleWithin(tolerance) {
+			sol.Status = solution_status.NUMERIC
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,179 @@
+package revised
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/algorithms"
+	revised_algorithm "github.com/MatProGo-dev/simplex/algorithms/revised"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestRevisedSimplexAlgorithm_Solve1
+Description:
+
+	Verifies that the revised simplex algorithm finds the optimal solution (125, 300)
+	of test problem 5 (whose variables are split into positive and negative parts).
+*/
+func TestRevisedSimplexAlgorithm_Solve1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	algo := revised_algorithm.RevisedSimplexAlgorithm{IterationLimit: 100}
+
+	// Test
+	sol, err := algo.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Status != solution_status.OPTIMAL {
+		t.Fatalf("Expected status OPTIMAL, but got %v", sol.Status)
+	}
+	x1, x2 := sol.VariableValues[prob.Variables[0].ID], sol.VariableValues[prob.Variables[1].ID]
+	if math.Abs(x1-125.0) > 1e-8 || math.Abs(x2-300.0) > 1e-8 {
+		t.Errorf("Expected solution (125, 300), but got (%v, %v)", x1, x2)
+	}
+	if math.Abs(sol.Objective-9375.0) > 1e-8 {
+		t.Errorf("Expected objective 9375, but got %v", sol.Objective)
+	}
+	if sol.Statistics.Pivots() != sol.Iterations {
+		t.Errorf("Expected %v pivots in the statistics, but got %v", sol.Iterations, sol.Statistics.Pivots())
+	}
+
+	// Solve through the solver
+	solver := simplexSolver.New("Sparse Test")
+	solver.Algorithm = algorithms.TypeSparseRevised
+	solverSol, err := solver.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if math.Abs(solverSol.Objective-9375.0) > 1e-8 {
+		t.Errorf("Expected objective 9375 from the solver, but got %v", solverSol.Objective)
+	}
+}
+
+/*
+TestRevisedSimplexAlgorithm_Solve2
+Description:
+
+	Verifies that the revised simplex algorithm and the tableau algorithm find the same
+	objective value on test problems 3 and 4 and on a minimization problem.
+*/
+func TestRevisedSimplexAlgorithm_Solve2(t *testing.T) {
+	// Setup
+	minimization := problem.NewProblem("Minimization")
+	x := minimization.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	y := minimization.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	minimization.SetObjective(x.Multiply(-2.0).Plus(y).Minus(3.0), problem.SenseMinimize)
+	minimization.Constraints = append(
+		minimization.Constraints,
+		x.Plus(y).LessEq(4.0),
+		x.Minus(y).LessEq(1.0),
+	)
+
+	for _, prob := range []*problem.OptimizationProblem{
+		examples.GetTestProblem3(),
+		examples.GetTestProblem4(),
+		minimization,
+	} {
+		// Test
+		revisedAlgo := revised_algorithm.RevisedSimplexAlgorithm{IterationLimit: 100}
+		sol, err := revisedAlgo.Solve(*prob)
+		if err != nil {
+			t.Fatalf("%v: expected no error, but got: %v", prob.Name, err)
+		}
+		tableauAlgo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+		expected, err := tableauAlgo.Solve(*prob)
+		if err != nil {
+			t.Fatalf("%v: expected no error from the tableau algorithm, but got: %v", prob.Name, err)
+		}
+
+		// Verify
+		if sol.Status != solution_status.OPTIMAL {
+			t.Errorf("%v: expected status OPTIMAL, but got %v", prob.Name, sol.Status)
+		}
+		if math.Abs(sol.Objective-expected.Objective) > 1e-8 {
+			t.Errorf("%v: expected objective %v, but got %v", prob.Name, expected.Objective, sol.Objective)
+		}
+	}
+}
+
+/*
+TestRevisedSimplexAlgorithm_Solve3
+Description:
+
+	Verifies that the revised simplex algorithm reports an unbounded problem.
+*/
+func TestRevisedSimplexAlgorithm_Solve3(t *testing.T) {
+	// Setup
+	unbounded := problem.NewProblem("Unbounded")
+	u := unbounded.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	w := unbounded.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	unbounded.SetObjective(u.Plus(w), problem.SenseMaximize)
+	unbounded.Constraints = append(unbounded.Constraints, u.Minus(w).LessEq(1.0))
+	algo := revised_algorithm.RevisedSimplexAlgorithm{IterationLimit: 100}
+
+	// Test
+	sol, err := algo.Solve(*unbounded)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Status != solution_status.UNBOUNDED {
+		t.Errorf("Expected status UNBOUNDED, but got %v", sol.Status)
+	}
+}
+
+/*
+TestRevisedSimplexAlgorithm_Solve4
+Description:
+
+	Verifies that the revised simplex algorithm solves a sparse problem with 3000
+	variables and 2999 constraints (maximize the sum of x_i subject to
+	x_i + x_{i+1} <= 1), whose dense tableau would have about 18 million entries,
+	and that the basis is refactorized along the way without changing the answer.
+*/
+func TestRevisedSimplexAlgorithm_Solve4(t *testing.T) {
+	// Setup
+	n := 3000
+	prob := problem.NewProblem("Chain")
+	xs := make([]symbolic.Variable, n)
+	objective := symbolic.Polynomial{}
+	for ii := range xs {
+		xs[ii] = prob.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+		objective.Monomials = append(objective.Monomials, xs[ii].ToMonomial())
+	}
+	prob.SetObjective(objective, problem.SenseMaximize)
+	for ii := 0; ii+1 < n; ii++ {
+		prob.Constraints = append(prob.Constraints, xs[ii].Plus(xs[ii+1]).LessEq(1.0))
+	}
+	algo := revised_algorithm.RevisedSimplexAlgorithm{IterationLimit: 10 * n}
+
+	// Test
+	sol, err := algo.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Status != solution_status.OPTIMAL {
+		t.Fatalf("Expected status OPTIMAL, but got %v", sol.Status)
+	}
+	if math.Abs(sol.Objective-float64(n/2)) > 1e-6 {
+		t.Errorf("Expected objective %v, but got %v", n/2, sol.Objective)
+	}
+	if sol.Statistics.Refactorizations == 0 {
+		t.Errorf("Expected the basis to be refactorized, but it never was")
+	}
+	if len(sol.Warnings) != 0 {
+		t.Errorf("Expected no warnings, but got %v", sol.Warnings)
+	}
+}
@@ -0,0 +1,121 @@
+package tableau
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestTableauAlgorithm_Basis1
+Description:
+
+	Verifies that the solution of test problem 5 reports its optimal basis:
+	x1 and x2 are basic, c2 and c3 are active (at their upper limits) and the
+	remaining constraints are basic.
+*/
+func TestTableauAlgorithm_Basis1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+
+	// Test
+	sol, err := algo.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Basis == nil {
+		t.Fatalf("Expected the solution to contain a basis, but it was nil")
+	}
+
+	for _, v := range prob.Variables {
+		if status := sol.Basis.VariableStatus[v.ID]; status != simplex_solution.BasisStatusBasic {
+			t.Errorf("Expected variable %v to be basic, but it was %v", v, status)
+		}
+	}
+
+	expected := []simplex_solution.BasisStatus{
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusAtUpper,
+		simplex_solution.BasisStatusAtUpper,
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusBasic,
+	}
+	if len(sol.Basis.ConstraintStatus) != len(expected) {
+		t.Fatalf("Expected %v constraint statuses, but got %v", len(expected), len(sol.Basis.ConstraintStatus))
+	}
+	for ii, status := range expected {
+		if sol.Basis.ConstraintStatus[ii] != status {
+			t.Errorf("Expected constraint %v to be %v, but it was %v", ii, status, sol.Basis.ConstraintStatus[ii])
+		}
+	}
+}
+
+/*
+TestTableauAlgorithm_Basis2
+Description:
+
+	Verifies that the basis reported by a solve of test problem 5 can be fed back
+	into a later solve, which then terminates without any pivots.
+*/
+func TestTableauAlgorithm_Basis2(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	first, err := (&tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}).Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100, InitialBasis: first.Basis}
+	second, err := algo.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if second.Status != solution_status.OPTIMAL || second.Iterations != 0 {
+		t.Errorf("Expected status OPTIMAL after 0 iterations, but got %v after %v", second.Status, second.Iterations)
+	}
+	if first.Iterations == 0 {
+		t.Errorf("Expected the first solve to need at least one pivot")
+	}
+}
+
+/*
+TestTableauAlgorithm_Basis3
+Description:
+
+	Verifies that a problem with an equality constraint (whose slack basis has fewer
+	basic variables than rows) is rejected with an error instead of being solved
+	from a basis that does not fit its rows.
+*/
+func TestTableauAlgorithm_Basis3(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("Basis3")
+	x := prob.AddVariableVectorClassic(2, 0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	prob.Constraints = append(
+		prob.Constraints,
+		x.AtVec(0).Plus(x.AtVec(1)).Eq(1.0),
+		x.AtVec(0).Plus(x.AtVec(1).Multiply(2.0)).LessEq(3.0),
+	)
+	prob.SetObjective(x.AtVec(0), problem.SenseMaximize)
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+
+	// Test
+	sol, err := algo.Solve(*prob)
+
+	// Verify
+	if err == nil || !strings.Contains(err.Error(), "equality constraints are not supported") {
+		t.Errorf("Expected an error for the equality constraint, but got %v (status %v)", err, sol.Status)
+	}
+}
@@ -0,0 +1,97 @@
+package tableau
+
+import (
+	"strings"
+	"testing"
+
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestHistoryToLaTeX1
+Description:
+
+	Verifies that the history of a full solve of test problem 5 is rendered as
+	three tableaus with two pivot annotations between them.
+*/
+func TestHistoryToLaTeX1(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	_, history, err := algo.SolveWithHistory(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	latex, err := tableau_algorithm1.HistoryToLaTeX(history)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if strings.Count(latex, "\\begin{tabular}") != 3 {
+		t.Errorf("Expected 3 tableaus, but got:\n%v", latex)
+	}
+
+	if strings.Count(latex, "enters the basis") != 2 {
+		t.Errorf("Expected 2 pivot annotations, but got:\n%v", latex)
+	}
+
+	if strings.Count(latex, "\\boxed{") != 2 {
+		t.Errorf("Expected 2 highlighted pivot elements, but got:\n%v", latex)
+	}
+
+	if !strings.Contains(latex, "Iteration 1: $x_{1}$ enters the basis and $x_{3}$ (slack) leaves the basis.") {
+		t.Errorf("Expected the first pivot to be annotated, but got:\n%v", latex)
+	}
+}
+
+/*
+TestHistoryToLaTeX2
+Description:
+
+	Verifies that HistoryToLaTeX returns an error if one of the tableaus is invalid.
+*/
+func TestHistoryToLaTeX2(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	_, history, err := algo.SolveWithHistory(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	invalidTableau := *history[2].Tableau
+	invalidTableau.BasicVariableIndicies = []int{0, 1, 100}
+	history[2].Tableau = &invalidTableau
+
+	// Test
+	_, err = tableau_algorithm1.HistoryToLaTeX(history)
+
+	// Verify
+	if err == nil {
+		t.Errorf("Expected an error for the invalid tableau, but got none")
+	}
+}
+
+/*
+TestPivotBetween1
+Description:
+
+	Verifies that PivotBetween returns an error for two identical states.
+*/
+func TestPivotBetween1(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	iterator, err := algo.NewIterator(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	_, _, err = tableau_algorithm1.PivotBetween(iterator.State, iterator.State)
+
+	// Verify
+	if err == nil {
+		t.Errorf("Expected an error for identical states, but got none")
+	}
+}
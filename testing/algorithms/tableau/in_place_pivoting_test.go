@@ -0,0 +1,207 @@
+package tableau
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	getKMatrix "github.com/MatProGo-dev/SymbolicMath.go/get/KMatrix"
+	getKVector "github.com/MatProGo-dev/SymbolicMath.go/get/KVector"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+randomDenseProblem
+Description:
+
+	Creates a random problem max c^T x s.t. A x <= b, x >= 0 with positive entries in A,
+	b and c (so that the slack basis is feasible and the problem is bounded).
+*/
+func randomDenseProblem(nConstraints, nVariables int, seed int64) *problem.OptimizationProblem {
+	// Setup
+	rng := rand.New(rand.NewSource(seed))
+	out := problem.NewProblem("RandomDense")
+	x := out.AddVariableVectorClassic(nVariables, 0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+
+	// Create the objective and the constraints
+	c := make([]float64, nVariables)
+	for jj := range c {
+		c[jj] = 1.0 + rng.Float64()
+	}
+	out.SetObjective(getKVector.From(c).Transpose().Multiply(x), problem.SenseMaximize)
+
+	A := make([][]float64, nConstraints)
+	b := make([]float64, nConstraints)
+	for ii := range A {
+		A[ii] = make([]float64, nVariables)
+		for jj := range A[ii] {
+			A[ii][jj] = 0.1 + rng.Float64()
+		}
+		b[ii] = float64(nVariables) * (1.0 + rng.Float64())
+	}
+	out.Constraints = append(out.Constraints, getKMatrix.From(A).Multiply(x).LessEq(getKVector.From(b)))
+
+	return out
+}
+
+/*
+TestTableauAlgorithm_InPlacePivoting1
+Description:
+
+	Verifies that pivoting in place visits the same bases as creating a new tableau for
+	every pivot (same iterations, values, objective and dual values) on several problems,
+	that the history only contains the current state and that the initial tableau is not
+	modified by the in-place pivots.
+*/
+func TestTableauAlgorithm_InPlacePivoting1(t *testing.T) {
+	for _, prob := range []*problem.OptimizationProblem{
+		examples.GetTestProblem3(),
+		examples.GetTestProblem4(),
+		examples.GetTestProblem5(),
+		randomDenseProblem(10, 15, 1),
+	} {
+		// Setup
+		copyAlgo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+		expected, err := copyAlgo.Solve(*prob)
+		if err != nil {
+			t.Fatalf("%v: expected no error, but got: %v", prob.Name, err)
+		}
+
+		inPlaceAlgo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100, InPlacePivoting: true}
+		iterator, err := inPlaceAlgo.NewIterator(*prob)
+		if err != nil {
+			t.Fatalf("%v: expected no error, but got: %v", prob.Name, err)
+		}
+		initialTableau := iterator.State.Tableau
+		initialMatrix := mat.DenseCopyOf(initialTableau.AsCompressedMatrix)
+
+		// Test
+		sol, err := iterator.Reoptimize()
+		if err != nil {
+			t.Fatalf("%v: expected no error, but got: %v", prob.Name, err)
+		}
+
+		// Verify
+		if sol.Status != solution_status.OPTIMAL || sol.Iterations != expected.Iterations {
+			t.Errorf(
+				"%v: expected status %v after %v iterations, but got %v after %v iterations",
+				prob.Name, expected.Status, expected.Iterations, sol.Status, sol.Iterations,
+			)
+		}
+		if math.Abs(sol.Objective-expected.Objective) > 1e-9 {
+			t.Errorf("%v: expected objective %v, but got %v", prob.Name, expected.Objective, sol.Objective)
+		}
+		for _, v := range prob.Variables {
+			if math.Abs(sol.VariableValues[v.ID]-expected.VariableValues[v.ID]) > 1e-9 {
+				t.Errorf("%v: expected %v = %v, but got %v", prob.Name, v.Name, expected.VariableValues[v.ID], sol.VariableValues[v.ID])
+			}
+		}
+		if !mat.EqualApprox(mat.NewVecDense(len(sol.DualValues), sol.DualValues), mat.NewVecDense(len(expected.DualValues), expected.DualValues), 1e-9) {
+			t.Errorf("%v: expected the dual values %v, but got %v", prob.Name, expected.DualValues, sol.DualValues)
+		}
+		if len(iterator.History) != 1 || iterator.History[0].Tableau != iterator.State.Tableau {
+			t.Errorf("%v: expected the history to only contain the current state, but it has %v states", prob.Name, len(iterator.History))
+		}
+		if !mat.Equal(initialTableau.AsCompressedMatrix, initialMatrix) {
+			t.Errorf("%v: expected the initial tableau to be unchanged by the in-place pivots", prob.Name)
+		}
+	}
+}
+
+/*
+TestTableauAlgorithm_InPlacePivoting2
+Description:
+
+	Verifies that the pivots of the tableau algorithm with in-place pivoting (and without
+	periodic refactorization) allocate no memory once the working copy of the tableau
+	has been created, and that they allocate memory without in-place pivoting.
+*/
+func TestTableauAlgorithm_InPlacePivoting2(t *testing.T) {
+	for _, inPlacePivoting := range []bool{true, false} {
+		// Setup
+		prob := randomDenseProblem(10, 15, 2)
+		algo := tableau_algorithm1.TableauAlgorithm{
+			IterationLimit:           1000,
+			InPlacePivoting:          inPlacePivoting,
+			RefactorizationFrequency: -1,
+		}
+		iterator, err := algo.NewIterator(*prob)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		initialState := iterator.State
+
+		// Test (the first run is a warm-up that creates the working copy)
+		allocs := testing.AllocsPerRun(5, func() {
+			iterator.State = initialState
+			iterator.History = append(iterator.History[:0], initialState)
+			for !iterator.Terminated() {
+				_, err = iterator.Next()
+				if err != nil {
+					t.Fatalf("Expected no error, but got: %v", err)
+				}
+			}
+		})
+
+		// Verify
+		if iterator.State.IterationCount == 0 {
+			t.Fatalf("Expected the problem to need pivots, but it needed none")
+		}
+		if inPlacePivoting && allocs != 0 {
+			t.Errorf("Expected the in-place pivots to allocate nothing, but they made %v allocations", allocs)
+		}
+		if !inPlacePivoting && allocs == 0 {
+			t.Errorf("Expected the pivots without in-place pivoting to allocate new tableaus, but they made no allocations")
+		}
+	}
+}
+
+/*
+benchmarkTableauAlgorithm
+Description:
+
+	Measures the pivots of the tableau algorithm (pivoting in place or not) on a random
+	10 x 15 problem. The iterator is created once and rewound to its initial state with
+	the timer stopped, so only the calls to Next until termination are measured and not
+	the construction of the initial tableau.
+*/
+func benchmarkTableauAlgorithm(b *testing.B, inPlacePivoting bool) {
+	// Setup
+	prob := randomDenseProblem(10, 15, 2)
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 1000, InPlacePivoting: inPlacePivoting}
+	iterator, err := algo.NewIterator(*prob)
+	if err != nil {
+		b.Fatalf("Expected no error, but got: %v", err)
+	}
+	initialState := iterator.State
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	// Pivot from the initial state until termination b.N times
+	for ii := 0; ii < b.N; ii++ {
+		b.StopTimer()
+		iterator.State = initialState
+		iterator.History = []tableau_algorithm1.TableauAlgorithmState{initialState}
+		b.StartTimer()
+
+		for !iterator.Terminated() {
+			_, err = iterator.Next()
+			if err != nil {
+				b.Fatalf("Expected no error, but got: %v", err)
+			}
+		}
+	}
+}
+
+func BenchmarkTableauAlgorithm_Solve(b *testing.B) {
+	benchmarkTableauAlgorithm(b, false)
+}
+
+func BenchmarkTableauAlgorithm_SolveInPlace(b *testing.B) {
+	benchmarkTableauAlgorithm(b, true)
+}
@@ -0,0 +1,219 @@
+package tableau
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestTableauAlgorithmIterator_Next1
+Description:
+
+	Verifies that each call to Next() performs exactly one pivot on
+	test problem 5 and that the iterator terminates after two pivots
+	with the optimal solution x1 = 125, x2 = 300.
+*/
+func TestTableauAlgorithmIterator_Next1(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	iterator, err := algo.NewIterator(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify that no pivot has been performed yet
+	if iterator.State.IterationCount != 0 {
+		t.Errorf("Expected initial iteration count to be 0, but got %v", iterator.State.IterationCount)
+	}
+
+	if iterator.Terminated() {
+		t.Errorf("Expected the initial state to not be terminal")
+	}
+
+	// Perform two pivots
+	for ii := 1; ii <= 2; ii++ {
+		state, err := iterator.Next()
+		if err != nil {
+			t.Fatalf("Expected no error at pivot %v, but got: %v", ii, err)
+		}
+
+		if state.IterationCount != ii {
+			t.Errorf("Expected iteration count %v, but got %v", ii, state.IterationCount)
+		}
+	}
+
+	// Verify termination
+	if !iterator.Terminated() {
+		t.Errorf("Expected the iterator to be terminated after two pivots")
+	}
+
+	if len(iterator.History) != 3 {
+		t.Errorf("Expected 3 states in the history, but got %v", len(iterator.History))
+	}
+
+	// Finish
+	sol, err := iterator.Finish()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if sol.Status != solution_status.OPTIMAL {
+		t.Errorf("Expected status OPTIMAL, but got %v", sol.Status)
+	}
+
+	if sol.VariableValues[0] != 125.0 || sol.VariableValues[1] != 300.0 {
+		t.Errorf("Expected solution (125, 300), but got %v", sol.VariableValues)
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_Next2
+Description:
+
+	Verifies that Next() returns an error once the iterator has terminated.
+*/
+func TestTableauAlgorithmIterator_Next2(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 1}
+	iterator, err := algo.NewIterator(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Perform the only allowed pivot
+	_, err = iterator.Next()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	_, err = iterator.Next()
+	if err == nil {
+		t.Errorf("Expected an error after the iteration limit was reached, but got none")
+	}
+
+	// Verify that the solution reports the iteration limit
+	sol, err := iterator.Finish()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if sol.Status != solution_status.ITERATION_LIMIT {
+		t.Errorf("Expected status ITERATION_LIMIT, but got %v", sol.Status)
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_NextWithPivot1
+Description:
+
+	Verifies that a user-chosen pivot is applied to the tableau.
+	In test problem 5, we bring x1 (index 0) into the basis in place of
+	the slack of the fourth constraint (index 5).
+*/
+func TestTableauAlgorithmIterator_NextWithPivot1(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	iterator, err := algo.NewIterator(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	state, err := iterator.NextWithPivot(0, 5)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if state.Tableau.BasicVariableIndicies[3] != 0 {
+		t.Errorf(
+			"Expected variable 0 to be basic in row 3, but got basic variables %v",
+			state.Tableau.BasicVariableIndicies,
+		)
+	}
+
+	// Finish the solve with the default rule
+	for !iterator.Terminated() {
+		_, err = iterator.Next()
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+	}
+
+	sol, err := iterator.Finish()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if sol.VariableValues[0] != 125.0 || sol.VariableValues[1] != 300.0 {
+		t.Errorf("Expected solution (125, 300), but got %v", sol.VariableValues)
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_NextWithPivot2
+Description:
+
+	Verifies that NextWithPivot() returns an error when the entering variable
+	is already basic.
+*/
+func TestTableauAlgorithmIterator_NextWithPivot2(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	iterator, err := algo.NewIterator(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	_, err = iterator.NextWithPivot(2, 3)
+	if err == nil {
+		t.Errorf("Expected an error when the entering variable is basic, but got none")
+	}
+
+	if iterator.State.IterationCount != 0 {
+		t.Errorf("Expected the state to be unchanged, but got iteration count %v", iterator.State.IterationCount)
+	}
+}
+
+/*
+TestTableauAlgorithm_SolveContext1
+Description:
+
+	Verifies that SolveContext stops before the first pivot when the context
+	is already cancelled or past its deadline and reports INTERRUPTED or
+	TIME_LIMIT respectively.
+*/
+func TestTableauAlgorithm_SolveContext1(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+
+	contexts := map[solution_status.SolutionStatus]context.Context{
+		solution_status.INTERRUPTED: cancelled,
+		solution_status.TIME_LIMIT:  expired,
+	}
+
+	for expected, ctx := range contexts {
+		// Test
+		sol, err := algo.SolveContext(ctx, *examples.GetTestProblem5())
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+
+		// Verify
+		if sol.Status != expected || sol.Iterations != 0 {
+			t.Errorf("Expected status %v after 0 iterations, but got %v after %v", expected, sol.Status, sol.Iterations)
+		}
+	}
+}
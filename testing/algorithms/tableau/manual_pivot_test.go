@@ -0,0 +1,162 @@
+package tableau
+
+import (
+	"errors"
+	"testing"
+
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestTableauAlgorithm_ApplyManualPivot1
+Description:
+
+	Verifies that a valid pivot chosen by name is applied to the tableau from
+	GetTableauExample1. Bringing the second variable into the basis in place of
+	the second slack variable is the pivot selected by the minimum ratio test (300 / 1).
+*/
+func TestTableauAlgorithm_ApplyManualPivot1(t *testing.T) {
+	// Setup
+	tableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+
+	// Test
+	newTableau, err := algo.ApplyManualPivot(*tableau, tableau.Variables[1].Name, tableau.Variables[3].Name)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if newTableau.BasicVariableIndicies[1] != 1 {
+		t.Errorf("Expected variable 1 to be basic in row 1, but got basic variables %v", newTableau.BasicVariableIndicies)
+	}
+
+	if newTableau.B().AtVec(1) != 300.0 {
+		t.Errorf("Expected the right hand side of row 1 to be 300, but got %v", newTableau.B().AtVec(1))
+	}
+}
+
+/*
+TestTableauAlgorithm_ApplyManualPivot2
+Description:
+
+	Verifies that each kind of invalid pivot is reported with the correct violation
+	and that Bland's Rule's choice (variable 1 enters, variable 3 exits) is suggested.
+*/
+func TestTableauAlgorithm_ApplyManualPivot2(t *testing.T) {
+	// Setup
+	tableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+
+	testCases := []struct {
+		Entering int
+		Exiting  int
+		Expected tableau_algorithm1.PivotViolation
+	}{
+		{3, 2, tableau_algorithm1.EnteringVariableIsBasic},
+		{1, 0, tableau_algorithm1.ExitingVariableIsNotBasic},
+		{0, 3, tableau_algorithm1.PivotElementIsNotPositive},
+		{1, 2, tableau_algorithm1.MinimumRatioTestViolated},
+	}
+
+	for _, tc := range testCases {
+		// Test
+		_, err := algo.ApplyManualPivot(*tableau, tableau.Variables[tc.Entering].Name, tableau.Variables[tc.Exiting].Name)
+
+		// Verify
+		var pivotErr tableau_algorithm1.InvalidPivotError
+		if !errors.As(err, &pivotErr) {
+			t.Errorf("Expected an InvalidPivotError for (%v, %v), but got: %v", tc.Entering, tc.Exiting, err)
+			continue
+		}
+
+		if pivotErr.Violation != tc.Expected {
+			t.Errorf("Expected violation %v for (%v, %v), but got %v", tc.Expected, tc.Entering, tc.Exiting, pivotErr.Violation)
+		}
+
+		if pivotErr.SuggestedEnteringVarIndex != 1 || pivotErr.SuggestedExitingVarIndex != 3 {
+			t.Errorf(
+				"Expected the suggestion (1, 3), but got (%v, %v)",
+				pivotErr.SuggestedEnteringVarIndex,
+				pivotErr.SuggestedExitingVarIndex,
+			)
+		}
+	}
+}
+
+/*
+TestTableauAlgorithm_ApplyManualPivot3
+Description:
+
+	Verifies that a pivot whose entering variable has a non-negative reduced cost
+	is rejected. After variable 1 enters in place of variable 3, the reduced cost
+	of variable 3 is 25.
+*/
+func TestTableauAlgorithm_ApplyManualPivot3(t *testing.T) {
+	// Setup
+	tableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+
+	tableau1, err := algo.ApplyManualPivot(*tableau, tableau.Variables[1].Name, tableau.Variables[3].Name)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	_, err = algo.ApplyManualPivot(tableau1, tableau.Variables[3].Name, tableau.Variables[4].Name)
+
+	// Verify
+	var pivotErr tableau_algorithm1.InvalidPivotError
+	if !errors.As(err, &pivotErr) {
+		t.Fatalf("Expected an InvalidPivotError, but got: %v", err)
+	}
+
+	if pivotErr.Violation != tableau_algorithm1.ReducedCostIsNotImproving {
+		t.Errorf("Expected violation %v, but got %v", tableau_algorithm1.ReducedCostIsNotImproving, pivotErr.Violation)
+	}
+
+	if pivotErr.ReducedCost != 25.0 {
+		t.Errorf("Expected the reduced cost to be 25, but got %v", pivotErr.ReducedCost)
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_NextWithNamedPivot1
+Description:
+
+	Verifies that an invalid named pivot leaves the iterator's state unchanged.
+*/
+func TestTableauAlgorithmIterator_NextWithNamedPivot1(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	iterator, err := algo.NewIterator(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	_, err = iterator.NextWithNamedPivot("x_1", "x_2 (slack)")
+	if err == nil {
+		t.Fatalf("Expected an error for a pivot that violates the minimum ratio test, but got none")
+	}
+
+	// Verify
+	if iterator.State.IterationCount != 0 {
+		t.Errorf("Expected the state to be unchanged, but got iteration count %v", iterator.State.IterationCount)
+	}
+
+	_, err = iterator.NextWithNamedPivot("x_1", "x_3 (slack)")
+	if err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+}
@@ -0,0 +1,339 @@
+package tableau
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+solveTestProblem5
+Description:
+
+	Creates an iterator for test problem 5, solves the problem and returns the
+	iterator (so that the problem can be modified) together with the problem's variables.
+*/
+func solveTestProblem5(t *testing.T) (*tableau_algorithm1.TableauAlgorithmIterator, symbolic.Variable, symbolic.Variable) {
+	prob := examples.GetTestProblem5()
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	iterator, err := algo.NewIterator(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	sol, err := iterator.Reoptimize()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if sol.Status != solution_status.OPTIMAL {
+		t.Fatalf("Expected the first solve to be optimal, but got status %v", sol.Status)
+	}
+
+	return iterator, prob.Variables[0], prob.Variables[1]
+}
+
+/*
+checkValues
+Description:
+
+	Verifies that the solution is optimal and that the variables have the expected values.
+*/
+func checkValues(t *testing.T, sol simplex_solution.SimplexSolution, variables []symbolic.Variable, expected []float64) {
+	if sol.Status != solution_status.OPTIMAL {
+		t.Errorf("Expected status OPTIMAL, but got %v", sol.Status)
+	}
+	for ii, v := range variables {
+		if value := sol.VariableValues[v.ID]; math.Abs(value-expected[ii]) > 1e-8 {
+			t.Errorf("Expected %v = %v, but got %v", v.Name, expected[ii], value)
+		}
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_AddConstraint1
+Description:
+
+	Verifies that adding the cut x1 <= 100 to the solved test problem 5 cuts off the
+	optimal solution (125, 300) and that the dual simplex method reaches the new
+	optimum (100, 300) in a single pivot.
+*/
+func TestTableauAlgorithmIterator_AddConstraint1(t *testing.T) {
+	// Setup
+	iterator, x1, x2 := solveTestProblem5(t)
+	iterationsBefore := iterator.State.IterationCount
+
+	// Test
+	err := iterator.AddConstraint(x1.LessEq(100.0))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	sol, err := iterator.Reoptimize()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	checkValues(t, sol, []symbolic.Variable{x1, x2}, []float64{100.0, 300.0})
+	if pivots := sol.Iterations - iterationsBefore; pivots != 1 {
+		t.Errorf("Expected 1 pivot after adding the cut, but got %v", pivots)
+	}
+	if len(sol.DualValues) != 7 || math.Abs(sol.DualValues[6]-15.0) > 1e-8 {
+		t.Errorf("Expected the cut to have the dual value 15, but the duals were %v", sol.DualValues)
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_AddConstraint2
+Description:
+
+	Verifies that adding the constraint x1 + x2 >= 1000 to test problem 5
+	(which requires x1 + x2 <= 450) is reported as infeasible.
+*/
+func TestTableauAlgorithmIterator_AddConstraint2(t *testing.T) {
+	// Setup
+	iterator, x1, x2 := solveTestProblem5(t)
+
+	// Test
+	err := iterator.AddConstraint(x1.Plus(x2).GreaterEq(1000.0))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	sol, err := iterator.Reoptimize()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Status != solution_status.INFEASIBLE {
+		t.Errorf("Expected status INFEASIBLE, but got %v", sol.Status)
+	}
+
+	// Equality constraints can not be added
+	if err := iterator.AddConstraint(x1.Eq(1.0)); err == nil {
+		t.Errorf("Expected an error when adding an equality constraint")
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_SetConstraintRHS1
+Description:
+
+	Verifies that lowering the right hand side of 4 x1 + 5 x2 <= 2000 (whose dual
+	value is 3.75) to 1800 moves the optimum of test problem 5 to (75, 300)
+	without any pivots.
+*/
+func TestTableauAlgorithmIterator_SetConstraintRHS1(t *testing.T) {
+	// Setup
+	iterator, x1, x2 := solveTestProblem5(t)
+	iterationsBefore := iterator.State.IterationCount
+
+	// Test
+	err := iterator.SetConstraintRHS(2, 1800.0)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	sol, err := iterator.Reoptimize()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	checkValues(t, sol, []symbolic.Variable{x1, x2}, []float64{75.0, 300.0})
+	if sol.Iterations != iterationsBefore {
+		t.Errorf("Expected no pivots after changing the right hand side, but got %v", sol.Iterations-iterationsBefore)
+	}
+
+	// Constraints that are not part of the standard form can not be changed
+	if err := iterator.SetConstraintRHS(4, 1.0); err == nil {
+		t.Errorf("Expected an error when changing the bound x1 >= 0")
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_SetObjectiveCoefficient1
+Description:
+
+	Verifies that raising the objective coefficient of x1 in test problem 5
+	from 15 to 30 moves the optimum to (350, 100).
+*/
+func TestTableauAlgorithmIterator_SetObjectiveCoefficient1(t *testing.T) {
+	// Setup
+	iterator, x1, x2 := solveTestProblem5(t)
+
+	// Test
+	err := iterator.SetObjectiveCoefficient(x1, 30.0)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	sol, err := iterator.Reoptimize()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	checkValues(t, sol, []symbolic.Variable{x1, x2}, []float64{350.0, 100.0})
+	if value := sol.GetOptimalValue(); math.Abs(value-13000.0) > 1e-6 {
+		t.Errorf("Expected the objective 13000, but got %v", value)
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_AddVariable1
+Description:
+
+	Verifies that adding the column of a variable x3 with objective coefficient 40
+	(which uses 1 unit of the first and 6 units of the third constraint of test
+	problem 5) makes x3 enter the basis, and that the result is the optimum
+	(0, 0, 1000/3) of the extended problem.
+*/
+func TestTableauAlgorithmIterator_AddVariable1(t *testing.T) {
+	// Setup
+	iterator, x1, x2 := solveTestProblem5(t)
+
+	// Test
+	x3, err := iterator.AddVariable(40.0, []float64{1.0, 0.0, 6.0, 0.0, 0.0, 0.0})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	sol, err := iterator.Reoptimize()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if x3.ID == x1.ID || x3.ID == x2.ID {
+		t.Errorf("Expected the new variable to have a new ID, but got %v", x3.ID)
+	}
+	checkValues(t, sol, []symbolic.Variable{x1, x2, x3}, []float64{0.0, 0.0, 1000.0 / 3.0})
+
+	// The coefficients must match the scalar constraints
+	if _, err := iterator.AddVariable(1.0, []float64{1.0}); err == nil {
+		t.Errorf("Expected an error when the number of coefficients is wrong")
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_AddVariable2
+Description:
+
+	Verifies that re-optimizing after each of a sequence of modifications that ends
+	with a new column reaches the optimum instead of cycling. The problem
+		max x0 + x1 s.t. x0 + 2 x1 <= 4, 3 x0 + x1 <= 6, x >= 0
+	gets the constraints x0 <= 1 and x0 >= 0.5, the right hand side 1 in the first
+	constraint, the objective coefficient 5 for x0 and a variable x2 with objective
+	coefficient 10 in the first two constraints. The last tableau has a degenerate row
+	with a negative entry in the entering column, which must not be chosen by the ratio
+	test. The optimum is x = (0.5, 0, 0.5) with objective 7.5.
+*/
+func TestTableauAlgorithmIterator_AddVariable2(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("AddVariable2")
+	x := prob.AddVariableVectorClassic(2, 0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	prob.Constraints = append(
+		prob.Constraints,
+		x.AtVec(0).Plus(x.AtVec(1).Multiply(2.0)).LessEq(4.0),
+		x.AtVec(0).Multiply(3.0).Plus(x.AtVec(1)).LessEq(6.0),
+	)
+	prob.SetObjective(x.AtVec(0).Plus(x.AtVec(1)), problem.SenseMaximize)
+	x0, x1 := prob.Variables[0], prob.Variables[1]
+
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	iterator, err := algo.NewIterator(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if _, err = iterator.Reoptimize(); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test (re-optimizing after every modification)
+	for _, modify := range []func() error{
+		func() error { return iterator.AddConstraint(x0.LessEq(1.0)) },
+		func() error { return iterator.AddConstraint(x0.GreaterEq(0.5)) },
+		func() error { return iterator.SetConstraintRHS(0, 1.0) },
+		func() error { return iterator.SetObjectiveCoefficient(x0, 5.0) },
+	} {
+		if err = modify(); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if _, err = iterator.Reoptimize(); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+	}
+	x2, err := iterator.AddVariable(10.0, []float64{1.0, 1.0, 0.0, 0.0})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	sol, err := iterator.Reoptimize()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	checkValues(t, sol, []symbolic.Variable{x0, x1, x2}, []float64{0.5, 0.0, 0.5})
+	if math.Abs(sol.Objective-7.5) > 1e-8 {
+		t.Errorf("Expected the objective 7.5, but got %v", sol.Objective)
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_AddVariable3
+Description:
+
+	Verifies that the variables created by AddConstraint (the slack of the new row) and
+	AddVariable are registered with the environments of the existing variables, so that
+	variables created later with symbolic.NewVariable in those environments get new IDs.
+*/
+func TestTableauAlgorithmIterator_AddVariable3(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("AddVariable3")
+	x := prob.AddVariableVectorClassic(2, 0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	prob.Constraints = append(prob.Constraints, x.AtVec(0).Plus(x.AtVec(1)).LessEq(4.0))
+	prob.SetObjective(x.AtVec(0).Plus(x.AtVec(1).Multiply(2.0)), problem.SenseMaximize)
+
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	iterator, err := algo.NewIterator(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	if err = iterator.AddConstraint(x.AtVec(0).LessEq(3.0)); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	v, err := iterator.AddVariable(1.0, []float64{1.0, 0.0})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	tableauVariables := iterator.State.Tableau.Variables
+	w := symbolic.NewVariable(prob)
+	slack := symbolic.NewVariable(tableauVariables[0].Environment)
+
+	// Verify
+	if !slices.Contains(prob.Variables, v) {
+		t.Errorf("Expected the problem to track the new variable %v", v)
+	}
+	for _, other := range prob.Variables[:len(prob.Variables)-1] {
+		if other.ID == w.ID {
+			t.Errorf("Expected the new variable %v to have a new ID, but %v has the same ID", w, other)
+		}
+	}
+	for _, other := range tableauVariables {
+		if other.ID == slack.ID {
+			t.Errorf("Expected the new variable %v to have a new ID, but %v has the same ID", slack, other)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package tableau
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	getKMatrix "github.com/MatProGo-dev/SymbolicMath.go/get/KMatrix"
+	getKVector "github.com/MatProGo-dev/SymbolicMath.go/get/KVector"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/algorithms"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestRationalTableauAlgorithm_SolveExact1
+Description:
+
+	Verifies that test problem 5 is solved exactly: (x1, x2) = (125, 300) with the
+	objective value 9375.
+*/
+func TestRationalTableauAlgorithm_SolveExact1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	algo := tableau_algorithm1.RationalTableauAlgorithm{IterationLimit: 100}
+
+	// Test
+	sol, err := algo.SolveExact(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Status != solution_status.OPTIMAL {
+		t.Fatalf("Expected status OPTIMAL, but got %v", sol.Status)
+	}
+	for jj, expected := range []*big.Rat{big.NewRat(125, 1), big.NewRat(300, 1)} {
+		if value := sol.VariableValues[prob.Variables[jj].ID]; value == nil || value.Cmp(expected) != 0 {
+			t.Errorf("Expected x%v = %v, but got %v", jj+1, expected, value)
+		}
+	}
+	if sol.Objective.Cmp(big.NewRat(9375, 1)) != 0 {
+		t.Errorf("Expected the objective value 9375, but got %v", sol.Objective)
+	}
+}
+
+/*
+TestRationalTableauAlgorithm_SolveExact2
+Description:
+
+	Verifies that the problem
+		maximize   x + y
+		subject to 0.3 x + 0.1 y <= 0.1, 0.1 x + 0.3 y <= 0.1, x >= 0, y >= 0
+	is solved exactly (x = y = 1/4 with the objective value 1/2), also through the
+	solver's exact-tableau algorithm, and that an unbounded problem is detected.
+*/
+func TestRationalTableauAlgorithm_SolveExact2(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("DecimalProblem")
+	x := prob.AddVariableVector(2)
+	prob.SetObjective(getKVector.From([]float64{1, 1}).Transpose().Multiply(x), problem.SenseMaximize)
+	A := getKMatrix.From([][]float64{
+		{0.3, 0.1},
+		{0.1, 0.3},
+		{-1, 0},
+		{0, -1},
+	})
+	prob.Constraints = append(prob.Constraints, A.Multiply(x).LessEq(getKVector.From([]float64{0.1, 0.1, 0, 0})))
+
+	algo := tableau_algorithm1.RationalTableauAlgorithm{IterationLimit: 100}
+
+	// Test
+	sol, err := algo.SolveExact(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	for _, v := range prob.Variables {
+		if value := sol.VariableValues[v.ID]; value == nil || value.Cmp(big.NewRat(1, 4)) != 0 {
+			t.Errorf("Expected %v = 1/4, but got %v", v.Name, value)
+		}
+	}
+	if sol.Objective.Cmp(big.NewRat(1, 2)) != 0 {
+		t.Errorf("Expected the objective value 1/2, but got %v", sol.Objective)
+	}
+
+	// Solve through the solver
+	solver := simplexSolver.New("Exact Test")
+	solver.Algorithm = algorithms.TypeExactTableau
+	floatSol, err := solver.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	for _, v := range prob.Variables {
+		if floatSol.VariableValues[v.ID] != 0.25 {
+			t.Errorf("Expected %v = 0.25, but got %v", v.Name, floatSol.VariableValues[v.ID])
+		}
+	}
+
+	// Unbounded problem
+	unbounded := problem.NewProblem("Unbounded")
+	u := unbounded.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	w := unbounded.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	unbounded.SetObjective(u.Plus(w), problem.SenseMaximize)
+	unbounded.Constraints = append(unbounded.Constraints, u.Minus(w).LessEq(1.0), u.GreaterEq(0.0), w.GreaterEq(0.0))
+
+	unboundedSol, err := algo.SolveExact(*unbounded)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if unboundedSol.Status != solution_status.UNBOUNDED || unboundedSol.VariableValues != nil {
+		t.Errorf("Expected status UNBOUNDED without values, but got %+v", unboundedSol)
+	}
+}
+
+/*
+TestRationalTableauAlgorithm_SolveExact3
+Description:
+
+	Verifies that solving a problem with an equality constraint (whose slack basis
+	has fewer basic variables than rows) returns an error.
+*/
+func TestRationalTableauAlgorithm_SolveExact3(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("Equality")
+	x := prob.AddVariableVectorClassic(2, 0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	prob.Constraints = append(
+		prob.Constraints,
+		x.AtVec(0).Plus(x.AtVec(1)).Eq(1.0),
+		x.AtVec(0).Plus(x.AtVec(1).Multiply(2.0)).LessEq(3.0),
+	)
+	prob.SetObjective(x.AtVec(0), problem.SenseMaximize)
+	algo := tableau_algorithm1.RationalTableauAlgorithm{IterationLimit: 100}
+
+	// Test
+	_, err := algo.SolveExact(*prob)
+
+	// Verify
+	if err == nil {
+		t.Errorf("Expected an error, but got none")
+	}
+}
+
+/*
+TestRationalTableauAlgorithm_SolveExact4
+Description:
+
+	Verifies that solving a problem with a >= constraint (whose surplus cannot start
+	in the slack basis) returns an error instead of a solution of the wrong problem.
+*/
+func TestRationalTableauAlgorithm_SolveExact4(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("GreaterEq")
+	x := prob.AddVariableVectorClassic(2, 0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	prob.Constraints = append(
+		prob.Constraints,
+		x.AtVec(0).Plus(x.AtVec(1)).GreaterEq(2.0),
+		x.AtVec(0).LessEq(3.0),
+	)
+	prob.SetObjective(x.AtVec(0).Plus(x.AtVec(1)), problem.SenseMinimize)
+	algo := tableau_algorithm1.RationalTableauAlgorithm{IterationLimit: 100}
+
+	// Test
+	_, err := algo.SolveExact(*prob)
+
+	// Verify
+	if err == nil || !strings.Contains(err.Error(), ">= constraints are not supported") {
+		t.Errorf("Expected an error for the >= constraint, but got: %v", err)
+	}
+}
+
+/*
+TestRationalTableauAlgorithm_SolveContext1
+Description:
+
+	Verifies that SolveContext stops before the first pivot when the context
+	is already cancelled or past its deadline and reports INTERRUPTED or
+	TIME_LIMIT respectively.
+*/
+func TestRationalTableauAlgorithm_SolveContext1(t *testing.T) {
+	// Setup
+	var algo algorithms.ContextAlgorithmInterface = &tableau_algorithm1.RationalTableauAlgorithm{IterationLimit: 100}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+
+	contexts := map[solution_status.SolutionStatus]context.Context{
+		solution_status.INTERRUPTED: cancelled,
+		solution_status.TIME_LIMIT:  expired,
+	}
+
+	for expected, ctx := range contexts {
+		// Test
+		sol, err := algo.SolveContext(ctx, *examples.GetTestProblem5())
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+
+		// Verify
+		if sol.Status != expected || sol.Iterations != 0 {
+			t.Errorf("Expected status %v after 0 iterations, but got %v after %v", expected, sol.Status, sol.Iterations)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package tableau
+
+import (
+	"math"
+	"testing"
+
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+TestTableauAlgorithm_Refactorization1
+Description:
+
+	Verifies that refactorizing the tableau after every pivot still finds the optimal
+	solution (125, 300) of test problem 5, that every refactorization is counted and
+	that no warnings are reported (since the pivots are exact on this problem).
+*/
+func TestTableauAlgorithm_Refactorization1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100, RefactorizationFrequency: 1}
+
+	// Test
+	sol, err := algo.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Status != solution_status.OPTIMAL {
+		t.Fatalf("Expected status OPTIMAL, but got %v", sol.Status)
+	}
+	x1, x2 := sol.VariableValues[prob.Variables[0].ID], sol.VariableValues[prob.Variables[1].ID]
+	if math.Abs(x1-125.0) > 1e-8 || math.Abs(x2-300.0) > 1e-8 {
+		t.Errorf("Expected solution (125, 300), but got (%v, %v)", x1, x2)
+	}
+	if sol.Statistics.Refactorizations != sol.Iterations {
+		t.Errorf("Expected %v refactorizations, but got %v", sol.Iterations, sol.Statistics.Refactorizations)
+	}
+	if len(sol.Warnings) != 0 {
+		t.Errorf("Expected no warnings, but got %v", sol.Warnings)
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_Refactorization1
+Description:
+
+	Verifies that an error in the right hand side of the current tableau (as if it had
+	been accumulated by the pivots) is detected by the residual check after the next
+	pivot, even though periodic refactorization is disabled, and that the refactorization
+	corrects it, reports a warning and still leads to the optimal solution of test problem 5.
+*/
+func TestTableauAlgorithmIterator_Refactorization1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100, RefactorizationFrequency: -1}
+	iterator, err := algo.NewIterator(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Perturb the right hand side of the last row of (a copy of) the current tableau
+	perturbed := *iterator.State.Tableau
+	perturbed.AsCompressedMatrix = mat.DenseCopyOf(perturbed.AsCompressedMatrix)
+	nRows, nCols := perturbed.AsCompressedMatrix.Dims()
+	perturbed.AsCompressedMatrix.Set(nRows-1, nCols-1, perturbed.AsCompressedMatrix.At(nRows-1, nCols-1)+1e-3)
+	iterator.State.Tableau = &perturbed
+
+	// Test
+	sol, err := iterator.Reoptimize()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Statistics.Refactorizations != 1 || len(sol.Warnings) != 1 {
+		t.Errorf(
+			"Expected 1 refactorization with a warning, but got %v refactorizations and the warnings %v",
+			sol.Statistics.Refactorizations,
+			sol.Warnings,
+		)
+	}
+	if sol.Status != solution_status.OPTIMAL {
+		t.Fatalf("Expected status OPTIMAL, but got %v", sol.Status)
+	}
+	x1, x2 := sol.VariableValues[prob.Variables[0].ID], sol.VariableValues[prob.Variables[1].ID]
+	if math.Abs(x1-125.0) > 1e-8 || math.Abs(x2-300.0) > 1e-8 {
+		t.Errorf("Expected solution (125, 300), but got (%v, %v)", x1, x2)
+	}
+}
@@ -0,0 +1,160 @@
+package tableau
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	getKMatrix "github.com/MatProGo-dev/SymbolicMath.go/get/KMatrix"
+	getKVector "github.com/MatProGo-dev/SymbolicMath.go/get/KVector"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+)
+
+/*
+getBadlyScaledProblem
+Description:
+
+	Creates test problem 5 with the variables x1 = 1e4 * u and x2 = 1e-3 * v and
+	its second constraint multiplied by 1e5:
+		maximize   15e4 u + 25e-3 v
+		subject to 1e4 u + 1e-3 v <= 450
+		           1e2 v <= 3e7
+		           4e4 u + 5e-3 v <= 2000
+		           1e4 u <= 350
+		           u >= 0, v >= 0
+	Its optimal solution is (u, v) = (0.0125, 3e5).
+*/
+func getBadlyScaledProblem() *problem.OptimizationProblem {
+	// Setup
+	out := problem.NewProblem("BadlyScaledProblem")
+	x := out.AddVariableVector(2)
+
+	// Objective and constraints
+	c := getKVector.From([]float64{15e4, 25e-3})
+	out.SetObjective(c.Transpose().Multiply(x), problem.SenseMaximize)
+
+	A := getKMatrix.From([][]float64{
+		{1e4, 1e-3},
+		{0, 1e2},
+		{4e4, 5e-3},
+		{1e4, 0},
+		{-1, 0},
+		{0, -1},
+	})
+	b := getKVector.From([]float64{450, 3e7, 2000, 350, 0, 0})
+	out.Constraints = append(out.Constraints, A.Multiply(x).LessEq(b))
+
+	return out
+}
+
+/*
+TestTableauAlgorithm_Scaling1
+Description:
+
+	Solves the badly scaled problem with every scaling method and verifies that the
+	primal values, the dual values and the reduced costs are those of the unscaled problem.
+*/
+func TestTableauAlgorithm_Scaling1(t *testing.T) {
+	for _, method := range []tableau_algorithm1.ScalingMethod{
+		tableau_algorithm1.NoScaling,
+		tableau_algorithm1.GeometricMeanScaling,
+		tableau_algorithm1.EquilibrationScaling,
+		tableau_algorithm1.GeometricMeanEquilibrationScaling,
+	} {
+		// Setup
+		prob := getBadlyScaledProblem()
+		algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100, Scaling: method}
+
+		// Test
+		sol, err := algo.Solve(*prob)
+		if err != nil {
+			t.Fatalf("Expected no error for %v scaling, but got: %v", method, err)
+		}
+
+		// Verify
+		if sol.Status != solution_status.OPTIMAL {
+			t.Fatalf("Expected status OPTIMAL for %v scaling, but got %v", method, sol.Status)
+		}
+
+		expectedValues := []float64{0.0125, 3e5}
+		for ii, v := range prob.Variables {
+			if value := sol.VariableValues[v.ID]; math.Abs(value-expectedValues[ii]) > 1e-8*(1+expectedValues[ii]) {
+				t.Errorf("Expected %v = %v for %v scaling, but got %v", v.Name, expectedValues[ii], method, value)
+			}
+		}
+
+		expectedDuals := []float64{0, 6.25e-5, 3.75, 0, 0, 0}
+		for ii, expected := range expectedDuals {
+			if math.Abs(sol.DualValues[ii]-expected) > 1e-8 {
+				t.Errorf("Expected dual %v = %v for %v scaling, but got %v", ii, expected, method, sol.DualValues[ii])
+			}
+		}
+
+		for _, v := range prob.Variables {
+			if math.Abs(sol.ReducedCosts[v.ID]) > 1e-6 {
+				t.Errorf("Expected a reduced cost of 0 for %v with %v scaling, but got %v", v.Name, method, sol.ReducedCosts[v.ID])
+			}
+		}
+	}
+}
+
+/*
+TestTableauAlgorithm_Scaling2
+Description:
+
+	Verifies that geometric mean scaling followed by equilibration reduces the ratio of
+	the largest to the smallest nonzero entry of the initial tableau's constraint rows
+	from about 1e8 to at most 1e2, that scaled problems cannot be modified and that
+	scaling methods can be converted to and from their names.
+*/
+func TestTableauAlgorithm_Scaling2(t *testing.T) {
+	// Setup
+	prob := getBadlyScaledProblem()
+	algo := tableau_algorithm1.TableauAlgorithm{
+		IterationLimit: 100,
+		Scaling:        tableau_algorithm1.GeometricMeanEquilibrationScaling,
+	}
+
+	// Test
+	iterator, err := algo.NewIterator(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify the spread of the entries
+	A := iterator.State.Tableau.A()
+	nRows, nCols := A.Dims()
+	smallest, largest := math.Inf(1), 0.0
+	for ii := 0; ii < nRows; ii++ {
+		for jj := 0; jj < nCols; jj++ {
+			if value := math.Abs(A.At(ii, jj)); value != 0 {
+				smallest, largest = math.Min(smallest, value), math.Max(largest, value)
+			}
+		}
+	}
+	if largest/smallest > 1e2 {
+		t.Errorf("Expected the scaled entries to lie within a factor of 1e2, but got [%v, %v]", smallest, largest)
+	}
+
+	// Modifications
+	if err := iterator.SetConstraintRHS(0, 500); err == nil {
+		t.Errorf("Expected an error when modifying a scaled problem, but got none")
+	}
+
+	// Names
+	for _, method := range []tableau_algorithm1.ScalingMethod{
+		tableau_algorithm1.NoScaling,
+		tableau_algorithm1.GeometricMeanScaling,
+		tableau_algorithm1.EquilibrationScaling,
+		tableau_algorithm1.GeometricMeanEquilibrationScaling,
+	} {
+		converted, err := tableau_algorithm1.ToScalingMethod(method.String())
+		if err != nil || converted != method {
+			t.Errorf("Expected %v to be converted back, but got %v (%v)", method, converted, err)
+		}
+	}
+	if _, err := tableau_algorithm1.ToScalingMethod("curtis-reid"); err == nil {
+		t.Errorf("Expected an error for an unknown scaling method, but got none")
+	}
+}
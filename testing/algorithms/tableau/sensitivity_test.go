@@ -0,0 +1,70 @@
+package tableau
+
+import (
+	"math"
+	"testing"
+
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestTableauAlgorithmIterator_DualValues1
+Description:
+
+	Verifies the dual values of test problem 5 at its optimum:
+	only the constraints x2 <= 300 and 4 x1 + 5 x2 <= 2000 are active and
+	their shadow prices are 6.25 and 3.75 (so that strong duality holds).
+*/
+func TestTableauAlgorithmIterator_DualValues1(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	sol, err := algo.Solve(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	expected := []float64{0.0, 6.25, 3.75, 0.0, 0.0, 0.0}
+	if len(sol.DualValues) != len(expected) {
+		t.Fatalf("Expected %v dual values, but got %v", len(expected), sol.DualValues)
+	}
+
+	for ii, dual := range sol.DualValues {
+		if math.Abs(dual-expected[ii]) > 1e-9 {
+			t.Errorf("Expected dual value %v to be %v, but got %v", ii, expected[ii], dual)
+		}
+	}
+
+	dualObjective := 300*sol.DualValues[1] + 2000*sol.DualValues[2]
+	if math.Abs(dualObjective-9375.0) > 1e-9 {
+		t.Errorf("Expected the dual objective to be 9375, but got %v", dualObjective)
+	}
+}
+
+/*
+TestTableauAlgorithmIterator_ReducedCosts1
+Description:
+
+	Verifies that both variables of test problem 5 are basic at the optimum
+	and therefore have a reduced cost of zero.
+*/
+func TestTableauAlgorithmIterator_ReducedCosts1(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	sol, err := algo.Solve(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if len(sol.ReducedCosts) != 2 {
+		t.Fatalf("Expected 2 reduced costs, but got %v", sol.ReducedCosts)
+	}
+
+	for id, reducedCost := range sol.ReducedCosts {
+		if math.Abs(reducedCost) > 1e-9 {
+			t.Errorf("Expected the reduced cost of variable %v to be 0, but got %v", id, reducedCost)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	getKMatrix "github.com/MatProGo-dev/SymbolicMath.go/get/KMatrix"
+	getKVector "github.com/MatProGo-dev/SymbolicMath.go/get/KVector"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestTableauAlgorithm_Statistics1
+Description:
+
+	Verifies that the statistics of a solve of test problem 5 count every pivot in the
+	primal simplex phase and report a total time, a basis condition number estimate
+	and an entry growth.
+*/
+func TestTableauAlgorithm_Statistics1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+
+	// Test
+	sol, err := algo.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Status != solution_status.OPTIMAL || sol.Iterations == 0 {
+		t.Fatalf("Expected status OPTIMAL after some iterations, but got %v after %v", sol.Status, sol.Iterations)
+	}
+
+	stats := sol.Statistics
+	primal := stats.Phase(simplex_solution.PhasePrimalSimplex)
+	if primal == nil || primal.Pivots != sol.Iterations || stats.Pivots() != sol.Iterations {
+		t.Fatalf("Expected %v pivots in the primal simplex phase, but got %+v", sol.Iterations, stats.Phases)
+	}
+	if stats.TotalTime <= 0 || stats.TotalTime < primal.Time {
+		t.Errorf("Expected a positive total time of at least %v, but got %v", primal.Time, stats.TotalTime)
+	}
+	if stats.BasisConditionEstimate < 1 {
+		t.Errorf("Expected a basis condition number estimate of at least 1, but got %v", stats.BasisConditionEstimate)
+	}
+	if stats.MaxEntryGrowth < 1 {
+		t.Errorf("Expected an entry growth of at least 1, but got %v", stats.MaxEntryGrowth)
+	}
+}
+
+/*
+TestTableauAlgorithm_Statistics2
+Description:
+
+	Verifies that the degenerate pivot of the problem
+		maximize   3 x + 2 y
+		subject to y <= 0, 2 x <= 1, x >= 0, y >= 0
+	(in which y enters the basis without changing the objective value) is counted.
+*/
+func TestTableauAlgorithm_Statistics2(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("DegenerateProblem")
+	x := symbolic.VariableVector{
+		prob.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous),
+		prob.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous),
+	}
+	prob.SetObjective(getKVector.From([]float64{3, 2}).Transpose().Multiply(x), problem.SenseMaximize)
+	A := getKMatrix.From([][]float64{
+		{0, 1},
+		{2, 0},
+	})
+	prob.Constraints = append(prob.Constraints, A.Multiply(x).LessEq(getKVector.From([]float64{0, 1})))
+
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+
+	// Test
+	sol, err := algo.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Status != solution_status.OPTIMAL {
+		t.Fatalf("Expected status OPTIMAL, but got %v", sol.Status)
+	}
+	if sol.Iterations != 2 || sol.Statistics.DegeneratePivots() != 1 {
+		t.Errorf(
+			"Expected 1 of 2 pivots to be degenerate, but got %v of %v",
+			sol.Statistics.DegeneratePivots(),
+			sol.Iterations,
+		)
+	}
+}
+
+/*
+TestTableauAlgorithm_Statistics3
+Description:
+
+	Verifies that the pivots that move test problem 5 to its optimal basis are counted in
+	the warm start phase (and not in the primal simplex phase), and that pivots chosen with
+	NextWithNamedPivot are counted in the manual phase.
+*/
+func TestTableauAlgorithm_Statistics3(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	basis := simplex_solution.NewBasisFromBasicVariables(prob.Variables[0].ID, prob.Variables[1].ID)
+	basis.ConstraintStatus = []simplex_solution.BasisStatus{
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusAtUpper,
+		simplex_solution.BasisStatusAtUpper,
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusBasic,
+	}
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100, InitialBasis: basis}
+
+	// Test
+	sol, err := algo.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if warmStart := sol.Statistics.Phase(simplex_solution.PhaseWarmStart); warmStart == nil || warmStart.Pivots == 0 {
+		t.Errorf("Expected pivots in the warm start phase, but got %+v", sol.Statistics.Phases)
+	}
+	if primal := sol.Statistics.Phase(simplex_solution.PhasePrimalSimplex); primal != nil && primal.Pivots != 0 {
+		t.Errorf("Expected no pivots in the primal simplex phase, but got %v", primal.Pivots)
+	}
+
+	// Manual pivots
+	algo = tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}
+	iterator, err := algo.NewIterator(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	_, err = iterator.NextWithNamedPivot("x_1", "x_3 (slack)")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	sol, err = iterator.Reoptimize()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if manual := sol.Statistics.Phase(simplex_solution.PhaseManual); manual == nil || manual.Pivots != 1 {
+		t.Errorf("Expected 1 pivot in the manual phase, but got %+v", sol.Statistics.Phases)
+	}
+}
+
+/*
+TestRationalTableauAlgorithm_Statistics1
+Description:
+
+	Verifies that the exact algorithm counts its pivots in the primal simplex phase.
+*/
+func TestRationalTableauAlgorithm_Statistics1(t *testing.T) {
+	// Setup
+	algo := tableau_algorithm1.RationalTableauAlgorithm{IterationLimit: 100}
+
+	// Test
+	sol, err := algo.SolveExact(*examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	primal := sol.Statistics.Phase(simplex_solution.PhasePrimalSimplex)
+	if primal == nil || primal.Pivots != sol.Iterations || sol.Statistics.TotalTime <= 0 {
+		t.Errorf("Expected %v pivots in the primal simplex phase and a positive total time, but got %+v", sol.Iterations, sol.Statistics)
+	}
+}
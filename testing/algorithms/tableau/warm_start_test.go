@@ -0,0 +1,139 @@
+package tableau
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestTableauAlgorithm_InitialBasis1
+Description:
+
+	Verifies that starting test problem 5 from its optimal basis (x1, x2 and the
+	slacks of c1 and c4 basic) finds the optimal solution without any pivots.
+*/
+func TestTableauAlgorithm_InitialBasis1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	basis := simplex_solution.NewBasisFromBasicVariables(prob.Variables[0].ID, prob.Variables[1].ID)
+	basis.ConstraintStatus = []simplex_solution.BasisStatus{
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusAtUpper,
+		simplex_solution.BasisStatusAtUpper,
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusBasic,
+	}
+	algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100, InitialBasis: basis}
+
+	// Test
+	sol, err := algo.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Status != solution_status.OPTIMAL || sol.Iterations != 0 {
+		t.Errorf("Expected status OPTIMAL after 0 iterations, but got %v after %v", sol.Status, sol.Iterations)
+	}
+
+	x1, x2 := sol.VariableValues[prob.Variables[0].ID], sol.VariableValues[prob.Variables[1].ID]
+	if math.Abs(x1-125.0) > 1e-8 || math.Abs(x2-300.0) > 1e-8 {
+		t.Errorf("Expected solution (125, 300), but got (%v, %v)", x1, x2)
+	}
+}
+
+/*
+TestTableauAlgorithm_InitialBasis2
+Description:
+
+	Verifies that bases which cannot be used as they are still lead to the optimal
+	solution of test problem 5: a basis with only x1 and x2 basic (which is infeasible
+	when the largest pivots are used and has to be repaired), a basis that asks for
+	more basic columns than there are rows and a basis that refers to a variable that
+	does not exist.
+*/
+func TestTableauAlgorithm_InitialBasis2(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	x1ID, x2ID := prob.Variables[0].ID, prob.Variables[1].ID
+
+	overfull := simplex_solution.NewBasisFromBasicVariables(x1ID, x2ID)
+	overfull.ConstraintStatus = []simplex_solution.BasisStatus{
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusBasic,
+		simplex_solution.BasisStatusBasic,
+	}
+
+	bases := []*simplex_solution.Basis{
+		simplex_solution.NewBasisFromBasicVariables(x1ID, x2ID),
+		overfull,
+		simplex_solution.NewBasisFromBasicVariables(x1ID + 999),
+	}
+
+	for ii, basis := range bases {
+		// Test
+		algo := tableau_algorithm1.TableauAlgorithm{IterationLimit: 100, InitialBasis: basis}
+		sol, err := algo.Solve(*prob)
+		if err != nil {
+			t.Fatalf("Expected no error for basis %v, but got: %v", ii, err)
+		}
+
+		// Verify
+		x1, x2 := sol.VariableValues[x1ID], sol.VariableValues[x2ID]
+		if sol.Status != solution_status.OPTIMAL || math.Abs(x1-125.0) > 1e-8 || math.Abs(x2-300.0) > 1e-8 {
+			t.Errorf("Expected basis %v to lead to the solution (125, 300), but got (%v, %v) (%v)", ii, x1, x2, sol.Status)
+		}
+	}
+}
+
+/*
+TestTableauAlgorithm_InitialBasis3
+Description:
+
+	Verifies that a problem with an equality constraint between two inequality
+	constraints (whose slack basis has fewer basic variables than rows) is rejected
+	with an error, with and without an initial basis, instead of failing while the
+	basis is matched to the slacks.
+*/
+func TestTableauAlgorithm_InitialBasis3(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("InitialBasis3")
+	x := prob.AddVariableVectorClassic(2, 0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	prob.Constraints = append(
+		prob.Constraints,
+		x.AtVec(0).Plus(x.AtVec(1).Multiply(2.0)).LessEq(4.0),
+		x.AtVec(0).Minus(x.AtVec(1)).Eq(0.0),
+		x.AtVec(0).Multiply(3.0).Plus(x.AtVec(1)).LessEq(6.0),
+	)
+	prob.SetObjective(x.AtVec(0).Plus(x.AtVec(1)), problem.SenseMaximize)
+
+	basis := &simplex_solution.Basis{
+		VariableStatus: map[uint64]simplex_solution.BasisStatus{},
+		ConstraintStatus: []simplex_solution.BasisStatus{
+			simplex_solution.BasisStatusAtLower,
+			simplex_solution.BasisStatusAtLower,
+			simplex_solution.BasisStatusBasic,
+		},
+	}
+
+	// Test
+	_, coldErr := (&tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}).NewIterator(*prob)
+	_, warmErr := (&tableau_algorithm1.TableauAlgorithm{IterationLimit: 100, InitialBasis: basis}).NewIterator(*prob)
+
+	// Verify
+	for _, err := range []error{coldErr, warmErr} {
+		if err == nil || !strings.Contains(err.Error(), "equality constraints are not supported") {
+			t.Errorf("Expected an error for the equality constraint, but got: %v", err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package benchmarks_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/simplex/benchmarks"
+)
+
+// The sizes are kept small because the construction of the dense initial tableau
+// grows quickly with the number of constraints.
+
+func BenchmarkKleeMinty(b *testing.B) {
+	benchmarks.RunAll(b, benchmarks.KleeMinty(4), benchmarks.KleeMinty(6), benchmarks.KleeMinty(8))
+}
+
+func BenchmarkRandomDense(b *testing.B) {
+	benchmarks.RunAll(b, benchmarks.RandomDense(5, 10, 1), benchmarks.RandomDense(10, 20, 1))
+}
+
+func BenchmarkRandomSparse(b *testing.B) {
+	benchmarks.RunAll(b, benchmarks.RandomSparse(10, 30, 0.2, 1), benchmarks.RandomSparse(20, 60, 0.1, 1))
+}
+
+func BenchmarkTransportation(b *testing.B) {
+	benchmarks.RunAll(b, benchmarks.Transportation(3, 4, 1), benchmarks.Transportation(5, 6, 1))
+}
+
+func BenchmarkAssignment(b *testing.B) {
+	benchmarks.RunAll(b, benchmarks.Assignment(4, 1), benchmarks.Assignment(6, 1))
+}
+
+func BenchmarkGonumBug1(b *testing.B) {
+	benchmarks.RunAll(b, benchmarks.GonumBug1())
+}
@@ -0,0 +1,181 @@
+package benchmarks_test
+
+import (
+	"math"
+	"testing"
+
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	revised_algorithm "github.com/MatProGo-dev/simplex/algorithms/revised"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/benchmarks"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+TestKleeMinty1
+Description:
+
+	Verifies that the tableau algorithm finds the optimum 5^n of the Klee–Minty cube
+	of dimension 4 and that Dantzig's rule (used by the revised simplex algorithm)
+	visits all 2^4 vertices, i.e., makes 2^4 - 1 pivots.
+*/
+func TestKleeMinty1(t *testing.T) {
+	// Setup
+	instance := benchmarks.KleeMinty(4)
+
+	// Test
+	tableauSol, err := (&tableau_algorithm1.TableauAlgorithm{IterationLimit: 100}).Solve(*instance.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	revisedSol, err := (&revised_algorithm.RevisedSimplexAlgorithm{IterationLimit: 100}).Solve(*instance.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if tableauSol.Status != solution_status.OPTIMAL || math.Abs(tableauSol.Objective-625.0) > 1e-9 {
+		t.Errorf("Expected the objective 625, but got %v (status %v)", tableauSol.Objective, tableauSol.Status)
+	}
+	if revisedSol.Status != solution_status.OPTIMAL || math.Abs(revisedSol.Objective-625.0) > 1e-9 {
+		t.Errorf("Expected the objective 625, but got %v (status %v)", revisedSol.Objective, revisedSol.Status)
+	}
+	if pivots := revisedSol.Statistics.Pivots(); pivots != 15 {
+		t.Errorf("Expected Dantzig's rule to make 15 pivots, but it made %v", pivots)
+	}
+}
+
+/*
+TestRandomSparse1
+Description:
+
+	Verifies that RandomSparse creates a problem with the requested size, about the
+	requested density and no empty row or column, and that the same seed gives the
+	same problem.
+*/
+func TestRandomSparse1(t *testing.T) {
+	// Setup
+	nConstraints, nVariables, density := 30, 60, 0.1
+	instance := benchmarks.RandomSparse(nConstraints, nVariables, density, 1)
+
+	// Test
+	form, _, err := utils.GetSparseStandardFormFrom(instance.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	again, _, err := utils.GetSparseStandardFormFrom(benchmarks.RandomSparse(nConstraints, nVariables, density, 1).Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	nRows, nCols := form.A.Dims()
+	if nRows != nConstraints || nCols != nVariables+nConstraints {
+		t.Fatalf("Expected a %v x %v standard form, but got %v x %v", nConstraints, nVariables+nConstraints, nRows, nCols)
+	}
+
+	// - Count the nonzero entries of the original columns
+	rowIsEmpty := make([]bool, nRows)
+	for ii := range rowIsEmpty {
+		rowIsEmpty[ii] = true
+	}
+	nnz := 0
+	for jj := 0; jj < nVariables; jj++ {
+		rows, _ := form.A.Column(jj)
+		if len(rows) == 0 {
+			t.Errorf("Expected column %v to have a nonzero entry, but it has none", jj)
+		}
+		for _, ii := range rows {
+			rowIsEmpty[ii] = false
+		}
+		nnz += len(rows)
+	}
+	for ii, isEmpty := range rowIsEmpty {
+		if isEmpty {
+			t.Errorf("Expected row %v to have a nonzero entry, but it has none", ii)
+		}
+	}
+	if actual := float64(nnz) / float64(nConstraints*nVariables); actual < 0.5*density || actual > 2*density {
+		t.Errorf("Expected a density of about %v, but got %v", density, actual)
+	}
+
+	if form.A.NNZ() != again.A.NNZ() {
+		t.Errorf("Expected the same seed to give the same problem, but the number of nonzeros changed from %v to %v", form.A.NNZ(), again.A.NNZ())
+	}
+}
+
+/*
+TestAssignment1
+Description:
+
+	Verifies that the optimal value of an assignment problem found by the tableau
+	algorithm equals the value of the best assignment (found by trying every permutation).
+*/
+func TestAssignment1(t *testing.T) {
+	// Setup
+	n := 4
+	instance := benchmarks.Assignment(n, 1)
+
+	// Test
+	sol, err := (&tableau_algorithm1.TableauAlgorithm{IterationLimit: 1000}).Solve(*instance.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	weights := instance.Problem.Objective.Expression.(symbolic.ScalarExpression).LinearCoeff(instance.Problem.Variables)
+	best := 0.0
+	var tryAll func(agent int, used []bool, value float64)
+	tryAll = func(agent int, used []bool, value float64) {
+		if agent == n {
+			best = max(best, value)
+			return
+		}
+		for task := 0; task < n; task++ {
+			if !used[task] {
+				used[task] = true
+				tryAll(agent+1, used, value+weights.AtVec(agent*n+task))
+				used[task] = false
+			}
+		}
+	}
+	tryAll(0, make([]bool, n), 0.0)
+
+	if sol.Status != solution_status.OPTIMAL || math.Abs(sol.Objective-best) > 1e-9 {
+		t.Errorf("Expected the objective %v, but got %v (status %v)", best, sol.Objective, sol.Status)
+	}
+}
+
+/*
+TestInstances1
+Description:
+
+	Verifies that the tableau algorithm and the revised simplex algorithm find the
+	same optimal value for the random and transportation instances of the benchmarks.
+*/
+func TestInstances1(t *testing.T) {
+	for _, instance := range []benchmarks.Instance{
+		benchmarks.RandomDense(5, 10, 1),
+		benchmarks.RandomSparse(10, 20, 0.2, 1),
+		benchmarks.Transportation(3, 4, 1),
+	} {
+		// Test
+		tableauSol, err := (&tableau_algorithm1.TableauAlgorithm{IterationLimit: 1000}).Solve(*instance.Problem)
+		if err != nil {
+			t.Fatalf("%v: Expected no error, but got: %v", instance.Name, err)
+		}
+		revisedSol, err := (&revised_algorithm.RevisedSimplexAlgorithm{IterationLimit: 1000}).Solve(*instance.Problem)
+		if err != nil {
+			t.Fatalf("%v: Expected no error, but got: %v", instance.Name, err)
+		}
+
+		// Verify
+		if tableauSol.Status != solution_status.OPTIMAL || revisedSol.Status != solution_status.OPTIMAL {
+			t.Fatalf("%v: Expected both solves to be optimal, but got %v and %v", instance.Name, tableauSol.Status, revisedSol.Status)
+		}
+		if math.Abs(tableauSol.Objective-revisedSol.Objective) > 1e-6*max(1.0, math.Abs(tableauSol.Objective)) {
+			t.Errorf("%v: Expected the same objective, but got %v and %v", instance.Name, tableauSol.Objective, revisedSol.Objective)
+		}
+	}
+}
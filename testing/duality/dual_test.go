@@ -0,0 +1,138 @@
+package duality_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/duality"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestDual1
+Description:
+
+	Verifies that the dual of test problem 5 (a maximization over free variables) is a
+	minimization with one sign-restricted variable per constraint and one equality per
+	variable, and that the dual values reported by the solver are feasible for it with
+	the same objective value (strong duality).
+*/
+func TestDual1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	solver := simplexSolver.New("Duality Test")
+	sol, err := solver.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	result, err := duality.Dual(prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify the structure
+	dual := result.Problem
+	if dual.Objective.Sense != problem.SenseMinimize || len(dual.Variables) != 6 || len(dual.Constraints) != 2 {
+		t.Fatalf("Expected a minimization with 6 variables and 2 constraints, but got %v", dual)
+	}
+	for kk, y := range result.ConstraintVariables {
+		if kk < 4 && (y.Lower != 0 || y.Upper < 1e30) {
+			t.Errorf("Expected the dual variable of <= constraint %v to be nonnegative, but got [%v, %v]", kk, y.Lower, y.Upper)
+		}
+		if kk >= 4 && (y.Upper != 0 || y.Lower > -1e30) {
+			t.Errorf("Expected the dual variable of >= constraint %v to be nonpositive, but got [%v, %v]", kk, y.Lower, y.Upper)
+		}
+		if result.ConstraintOf(y) != kk {
+			t.Errorf("Expected the dual variable %v to belong to constraint %v, but got %v", y.Name, kk, result.ConstraintOf(y))
+		}
+	}
+	for jj, v := range prob.Variables {
+		if result.VariableConstraints[v.ID] != jj {
+			t.Errorf("Expected the dual constraint of %v to be %v, but got %v", v.Name, jj, result.VariableConstraints[v.ID])
+		}
+		if dual.Constraints[jj].(symbolic.ScalarConstraint).ConstrSense() != symbolic.SenseEqual {
+			t.Errorf("Expected the dual constraint of the free variable %v to be an equality", v.Name)
+		}
+	}
+
+	// Verify strong duality with the reported dual values
+	dualSol := simplex_solution.SimplexSolution{VariableValues: map[uint64]float64{}, OriginalProblem: dual}
+	for kk, y := range result.ConstraintVariables {
+		dualSol.VariableValues[y.ID] = sol.DualValues[kk]
+	}
+	report, err := dualSol.Verify()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !report.PrimalFeasibleWithin(1e-9) {
+		t.Errorf("Expected the reported dual values to be dual feasible, but got %+v", report)
+	}
+	if math.Abs(report.PrimalObjective-sol.Objective) > 1e-9 {
+		t.Errorf("Expected the dual objective value %v, but got %v", sol.Objective, report.PrimalObjective)
+	}
+}
+
+/*
+TestDual2
+Description:
+
+	Verifies the dual of the problem
+		minimize   x + 2 y
+		subject to x + y >= 2, y >= 0
+	where 0 <= x <= 1 and y is free: the upper bound of x gets a nonpositive dual
+	variable, the dual constraint of x is a <= inequality and the optimal dual solution
+	(2, 0, -1) has the primal optimal value 3.
+*/
+func TestDual2(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("BoundedProblem")
+	x := prob.AddVariableClassic(0, 1, symbolic.Continuous)
+	y := prob.AddVariableClassic(-symbolic.Infinity.Constant(), symbolic.Infinity.Constant(), symbolic.Continuous)
+	prob.SetObjective(x.Plus(y.Multiply(2.0)), problem.SenseMinimize)
+	prob.Constraints = append(prob.Constraints, x.Plus(y).GreaterEq(2.0), y.GreaterEq(0.0))
+
+	// Test
+	result, err := duality.Dual(prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify the structure
+	dual := result.Problem
+	upper, found := result.UpperBoundVariables[x.ID]
+	if !found || len(result.LowerBoundVariables) != 0 || len(dual.Variables) != 3 {
+		t.Fatalf("Expected one dual variable for the upper bound of x, but got %+v", result)
+	}
+	if upper.Upper != 0 || result.ConstraintVariables[0].Lower != 0 {
+		t.Errorf("Expected y_upper <= 0 and y_0 >= 0, but got %v and %v", upper, result.ConstraintVariables[0])
+	}
+	if dual.Objective.Sense != problem.SenseMaximize {
+		t.Errorf("Expected the dual to be a maximization")
+	}
+	if sense := dual.Constraints[result.VariableConstraints[x.ID]].(symbolic.ScalarConstraint).ConstrSense(); sense != symbolic.SenseLessThanEqual {
+		t.Errorf("Expected the dual constraint of x to be a <= constraint, but got %v", sense)
+	}
+
+	// Verify the optimal dual solution
+	dualSol := simplex_solution.SimplexSolution{
+		VariableValues: map[uint64]float64{
+			result.ConstraintVariables[0].ID: 2,
+			result.ConstraintVariables[1].ID: 0,
+			upper.ID:                         -1,
+		},
+		OriginalProblem: dual,
+	}
+	report, err := dualSol.Verify()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !report.PrimalFeasibleWithin(1e-12) || report.PrimalObjective != 3 {
+		t.Errorf("Expected a feasible dual solution with the objective value 3, but got %+v", report)
+	}
+}
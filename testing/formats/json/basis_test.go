@@ -0,0 +1,84 @@
+package json_format_test
+
+import (
+	"strings"
+	"testing"
+
+	json_format "github.com/MatProGo-dev/simplex/formats/json"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+)
+
+/*
+TestMarshalBasis1
+Description:
+
+	Verifies that the optimal basis of test problem 5 (x1, x2, the slacks of
+	c1 and c4 basic; c2 and c3 at their upper limits) round-trips through
+	MarshalBasis and UnmarshalBasis and is written with names.
+*/
+func TestMarshalBasis1(t *testing.T) {
+	// Setup
+	model := readProblem5(t)
+	x1, _ := model.Variable("x1")
+	x2, _ := model.Variable("x2")
+
+	basis := &simplex_solution.Basis{
+		VariableStatus: map[uint64]simplex_solution.BasisStatus{
+			x1.ID: simplex_solution.BasisStatusBasic,
+			x2.ID: simplex_solution.BasisStatusBasic,
+		},
+		ConstraintStatus: make([]simplex_solution.BasisStatus, len(model.ScalarConstraintNames())),
+	}
+	basis.ConstraintStatus[1] = simplex_solution.BasisStatusAtUpper
+	basis.ConstraintStatus[2] = simplex_solution.BasisStatusAtUpper
+
+	// Test
+	data, err := json_format.MarshalBasis(basis, model)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	roundTrip, err := json_format.UnmarshalBasis(data, model)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if !strings.Contains(string(data), `"name": "c2"`) || !strings.Contains(string(data), `"at_upper"`) {
+		t.Errorf("Expected the statuses to be written by name, but got:\n%s", data)
+	}
+
+	for id, status := range basis.VariableStatus {
+		if roundTrip.VariableStatus[id] != status {
+			t.Errorf("Expected variable %v to be %v, but got %v", id, status, roundTrip.VariableStatus[id])
+		}
+	}
+
+	for ii, status := range basis.ConstraintStatus {
+		if roundTrip.ConstraintStatus[ii] != status {
+			t.Errorf("Expected constraint %v to be %v, but got %v", ii, status, roundTrip.ConstraintStatus[ii])
+		}
+	}
+}
+
+/*
+TestUnmarshalBasis1
+Description:
+
+	Verifies that a basis with an unknown status is rejected.
+*/
+func TestUnmarshalBasis1(t *testing.T) {
+	// Setup
+	model := readProblem5(t)
+	document := `{"version": 1, "variables": {"x1": "sideways"}, "constraints": [
+		{"name": "c1", "status": "basic"}, {"name": "c2", "status": "basic"},
+		{"name": "c3", "status": "basic"}, {"name": "c4", "status": "basic"}]}`
+
+	// Test
+	_, err := json_format.UnmarshalBasis([]byte(document), model)
+
+	// Verify
+	if err == nil || !strings.Contains(err.Error(), "sideways") {
+		t.Errorf("Expected an error about the status \"sideways\", but got: %v", err)
+	}
+}
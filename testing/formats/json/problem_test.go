@@ -0,0 +1,205 @@
+package json_format_test
+
+import (
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+	json_format "github.com/MatProGo-dev/simplex/formats/json"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+)
+
+/*
+readProblem5
+Description:
+
+	Reads the JSON encoding of test problem 5 from the testdata directory.
+*/
+func readProblem5(t *testing.T) *formats.Model {
+	data, err := os.ReadFile("testdata/problem5.json")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	model, err := json_format.UnmarshalModel(data)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	return model
+}
+
+/*
+TestUnmarshalModel1
+Description:
+
+	Verifies that test problem 5 can be read from JSON and that solving it
+	gives the optimal solution x1 = 125, x2 = 300.
+*/
+func TestUnmarshalModel1(t *testing.T) {
+	// Setup
+	model := readProblem5(t)
+
+	// Solve
+	solver := simplexSolver.New("JSON Test")
+	sol, err := solver.Solve(*model.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if model.Problem.Objective.Sense != problem.SenseMaximize {
+		t.Errorf("Expected a maximization problem, but got %v", model.Problem.Objective.Sense)
+	}
+
+	values := model.ValuesByName(sol.VariableValues)
+	if math.Abs(values["x1"]-125.0) > 1e-8 || math.Abs(values["x2"]-300.0) > 1e-8 {
+		t.Errorf("Expected x1 = 125 and x2 = 300, but got %v", values)
+	}
+}
+
+/*
+TestUnmarshalModel2
+Description:
+
+	Verifies that documents with an unknown version, an unknown variable or
+	an unknown objective sense are rejected.
+*/
+func TestUnmarshalModel2(t *testing.T) {
+	// Setup
+	documents := map[string]string{
+		"version":  `{"version": 2, "objective": {"sense": "minimize"}}`,
+		"variable": `{"version": 1, "objective": {"sense": "minimize", "coefficients": {"z": 1}}}`,
+		"sense":    `{"version": 1, "objective": {"sense": "sideways"}}`,
+	}
+
+	for expected, document := range documents {
+		// Test
+		_, err := json_format.UnmarshalModel([]byte(document))
+
+		// Verify
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected an error about the %v, but got: %v", expected, err)
+		}
+	}
+}
+
+/*
+TestMarshalModel1
+Description:
+
+	Verifies that a model with a ranged constraint, an equality constraint,
+	bounded, free and binary variables and an objective constant round-trips
+	through MarshalModel and UnmarshalModel.
+*/
+func TestMarshalModel1(t *testing.T) {
+	// Setup
+	model := formats.NewModel("RoundTrip")
+	x, _ := model.AddVariable("x", 0.0, 4.0, symbolic.Continuous)
+	y, _ := model.AddVariable("y", -symbolic.Infinity.Constant(), symbolic.Infinity.Constant(), symbolic.Continuous)
+	b, _ := model.AddVariable("b", 0.0, 1.0, symbolic.Binary)
+
+	objective := formats.LinearExpression([]float64{1.0, -2.0, 0.5}, []symbolic.Variable{x, y, b}, 3.0)
+	err := model.Problem.SetObjective(objective, problem.SenseMinimize)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	rangeExpr := formats.LinearExpression([]float64{1.0, 1.0}, []symbolic.Variable{x, y}, 0.0)
+	model.AddConstraint("range", rangeExpr.GreaterEq(-1.0))
+	model.AddConstraint("range", rangeExpr.LessEq(2.0))
+	model.AddConstraint("balance", formats.LinearExpression([]float64{1.0, -1.0}, []symbolic.Variable{y, b}, 0.0).Eq(0.5))
+	for _, v := range model.Problem.Variables {
+		err := model.AddBoundConstraints(v)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+	}
+
+	// Test
+	data, err := json_format.MarshalModel(model)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	roundTrip, err := json_format.UnmarshalModel(data)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v\n%s", err, data)
+	}
+
+	// Verify
+	if strings.Join(roundTrip.ConstraintNames, ",") != strings.Join(model.ConstraintNames, ",") {
+		t.Fatalf("Expected the constraints %v, but got %v", model.ConstraintNames, roundTrip.ConstraintNames)
+	}
+
+	for ii := range model.Problem.Constraints {
+		expected := model.Problem.Constraints[ii].(symbolic.ScalarConstraint).String()
+		got := roundTrip.Problem.Constraints[ii].(symbolic.ScalarConstraint).String()
+		if expected != got {
+			t.Errorf("Expected constraint %v to be %v, but got %v", ii, expected, got)
+		}
+	}
+
+	for name := range model.VariableIDs {
+		original, _ := model.Variable(name)
+		v, err := roundTrip.Variable(name)
+		if err != nil {
+			t.Errorf("Expected the variable %v to exist, but got: %v", name, err)
+			continue
+		}
+		if v.Lower != original.Lower || v.Upper != original.Upper || v.Type != original.Type {
+			t.Errorf("Expected %v to be %v, but got %v", name, original, v)
+		}
+	}
+
+	expectedObjective, expectedConstant, _ := model.LinearObjective()
+	gotObjective, gotConstant, _ := roundTrip.LinearObjective()
+	if gotConstant != expectedConstant {
+		t.Errorf("Expected the objective constant to be %v, but got %v", expectedConstant, gotConstant)
+	}
+	for ii := range expectedObjective {
+		if gotObjective[ii] != expectedObjective[ii] {
+			t.Errorf("Expected objective coefficient %v to be %v, but got %v", ii, expectedObjective[ii], gotObjective[ii])
+		}
+	}
+}
+
+/*
+TestMarshalModel2
+Description:
+
+	Verifies that a constraint without a finite bound (which ToModel would reject)
+	is left out of the encoding, so that the model still round-trips.
+*/
+func TestMarshalModel2(t *testing.T) {
+	// Setup
+	model := formats.NewModel("FreeRow")
+	x, _ := model.AddVariable("x", 0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	y, _ := model.AddVariable("y", 0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+
+	err := model.Problem.SetObjective(formats.LinearExpression([]float64{1.0, 1.0}, []symbolic.Variable{x, y}, 0.0), problem.SenseMinimize)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	model.AddConstraint("free", formats.LinearExpression([]float64{1.0, 2.0}, []symbolic.Variable{x, y}, 0.0).LessEq(symbolic.Infinity.Constant()))
+	model.AddConstraint("demand", formats.LinearExpression([]float64{1.0, 1.0}, []symbolic.Variable{x, y}, 0.0).GreaterEq(1.0))
+
+	// Test
+	data, err := json_format.MarshalModel(model)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	roundTrip, err := json_format.UnmarshalModel(data)
+
+	// Verify
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v\n%s", err, data)
+	}
+	if strings.Join(roundTrip.ConstraintNames, ",") != "demand" {
+		t.Errorf("Expected only the constraint demand, but got %v", roundTrip.ConstraintNames)
+	}
+}
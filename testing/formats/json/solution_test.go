@@ -0,0 +1,114 @@
+package json_format_test
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	json_format "github.com/MatProGo-dev/simplex/formats/json"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+)
+
+/*
+TestMarshalSolution1
+Description:
+
+	Verifies that the solution of test problem 5 (with the dual values and reduced
+	costs of its optimum) is encoded with variable and constraint names (rather
+	than IDs) and that it round-trips through MarshalSolution and UnmarshalSolution.
+*/
+func TestMarshalSolution1(t *testing.T) {
+	// Setup
+	model := readProblem5(t)
+	solver := simplexSolver.New("JSON Test")
+	sol, err := solver.Solve(*model.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// (the optimum is x1 = 125, x2 = 300, at which c2 and c3 are active)
+	sol.DualValues = []float64{0.0, 6.25, 3.75, 0.0}
+	sol.ReducedCosts = map[uint64]float64{}
+	for id := range sol.VariableValues {
+		sol.ReducedCosts[id] = 0.0
+	}
+
+	// Test
+	data, err := json_format.MarshalSolution(&sol, model)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	var doc json_format.SolutionDocument
+	err = json.Unmarshal(data, &doc)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	roundTrip, err := json_format.UnmarshalSolution(data, model)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify the document
+	if doc.Status != "OPTIMAL" || doc.Iterations != sol.Iterations {
+		t.Errorf("Expected status OPTIMAL after %v iterations, but got %v after %v", sol.Iterations, doc.Status, doc.Iterations)
+	}
+
+	if math.Abs(doc.Variables["x1"]-125.0) > 1e-8 || math.Abs(doc.Variables["x2"]-300.0) > 1e-8 {
+		t.Errorf("Expected x1 = 125 and x2 = 300, but got %v", doc.Variables)
+	}
+
+	if len(doc.Duals) < 3 || doc.Duals[1].Name != "c2" || math.Abs(doc.Duals[2].Value-3.75) > 1e-8 {
+		t.Errorf("Expected the duals of c2 and c3 to be 6.25 and 3.75, but got %v", doc.Duals)
+	}
+
+	// Verify the round trip
+	if roundTrip.Status != solution_status.OPTIMAL || roundTrip.Iterations != sol.Iterations {
+		t.Errorf("Expected status %v after %v iterations, but got %v after %v", sol.Status, sol.Iterations, roundTrip.Status, roundTrip.Iterations)
+	}
+
+	for id, value := range sol.VariableValues {
+		if roundTrip.VariableValues[id] != value {
+			t.Errorf("Expected variable %v to be %v, but got %v", id, value, roundTrip.VariableValues[id])
+		}
+		if roundTrip.ReducedCosts[id] != sol.ReducedCosts[id] {
+			t.Errorf("Expected the reduced cost of %v to be %v, but got %v", id, sol.ReducedCosts[id], roundTrip.ReducedCosts[id])
+		}
+	}
+
+	for ii, dual := range sol.DualValues {
+		if roundTrip.DualValues[ii] != dual {
+			t.Errorf("Expected dual value %v to be %v, but got %v", ii, dual, roundTrip.DualValues[ii])
+		}
+	}
+}
+
+/*
+TestUnmarshalSolution1
+Description:
+
+	Verifies that solutions which refer to unknown variables, have an unknown
+	status or list the duals of other constraints are rejected.
+*/
+func TestUnmarshalSolution1(t *testing.T) {
+	// Setup
+	model := readProblem5(t)
+	documents := map[string]string{
+		"unknown variable":        `{"version": 1, "status": "OPTIMAL", "variables": {"z": 1}}`,
+		"unknown solution status": `{"version": 1, "status": "PERFECT", "variables": {}}`,
+		"dual value":              `{"version": 1, "status": "OPTIMAL", "variables": {}, "duals": [{"name": "c1", "value": 0}]}`,
+	}
+
+	for expected, document := range documents {
+		// Test
+		_, err := json_format.UnmarshalSolution([]byte(document), model)
+
+		// Verify
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected an error about the %v, but got: %v", expected, err)
+		}
+	}
+}
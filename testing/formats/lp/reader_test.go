@@ -0,0 +1,218 @@
+package lp_format_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	lp_format "github.com/MatProGo-dev/simplex/formats/lp"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+// exampleLP exercises most of the features of the LP format.
+const exampleLP = `\Problem name: EXAMPLE
+\ A comment on its own line
+Maximize
+ obj: 3 x + 2 y
+   - z + 1.5 \ a comment after a term
+Subject To
+ capacity: x + y + z <= 10
+ - x + 2y >= -4
+ balance: x - z = 1
+ range: -2 <= x - y <= 5e+00
+Bounds
+ x <= 8
+ -1 <= y <= 3
+ z free
+ 2 <= w
+Generals
+ w
+Binaries
+ b
+End
+`
+
+/*
+TestRead1
+Description:
+
+	Verifies that the objective, constraint names, senses and ranged rows
+	of the example LP are read correctly.
+*/
+func TestRead1(t *testing.T) {
+	// Setup
+	model, err := lp_format.Read(strings.NewReader(exampleLP))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify the problem
+	if model.Problem.Name != "EXAMPLE" {
+		t.Errorf("Expected the problem name EXAMPLE, but got %v", model.Problem.Name)
+	}
+
+	if model.Problem.Objective.Sense != problem.SenseMaximize {
+		t.Errorf("Expected a maximization problem, but got %v", model.Problem.Objective.Sense)
+	}
+
+	objective := model.Problem.Objective.Expression.(symbolic.ScalarExpression)
+	if objective.Constant() != 1.5 {
+		t.Errorf("Expected the objective constant to be 1.5, but got %v", objective.Constant())
+	}
+
+	// Verify the constraints
+	expectedNames := []string{"capacity", "R2", "balance", "range", "range", "x_ub", "y_lb", "y_ub", "w_lb", "b_ub"}
+	if strings.Join(model.ConstraintNames, ",") != strings.Join(expectedNames, ",") {
+		t.Fatalf("Expected the constraints %v, but got %v", expectedNames, model.ConstraintNames)
+	}
+
+	expectedSenses := []symbolic.ConstrSense{
+		symbolic.SenseLessThanEqual,
+		symbolic.SenseGreaterThanEqual,
+		symbolic.SenseEqual,
+		symbolic.SenseGreaterThanEqual,
+		symbolic.SenseLessThanEqual,
+	}
+	for ii, sense := range expectedSenses {
+		if model.Problem.Constraints[ii].ConstrSense() != sense {
+			t.Errorf("Expected constraint %v to have sense %v, but got %v", ii, sense, model.Problem.Constraints[ii].ConstrSense())
+		}
+	}
+
+	upperRange := model.Problem.Constraints[4].Right().(symbolic.ScalarExpression).Constant()
+	if upperRange != 5.0 {
+		t.Errorf("Expected the upper limit of the range to be 5, but got %v", upperRange)
+	}
+}
+
+/*
+TestRead2
+Description:
+
+	Verifies that the bounds and types of the variables of the example LP are read correctly.
+*/
+func TestRead2(t *testing.T) {
+	// Setup
+	model, err := lp_format.Read(strings.NewReader(exampleLP))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	inf := symbolic.Infinity.Constant()
+
+	// Verify
+	expected := map[string]struct {
+		lower, upper float64
+		vtype        symbolic.VarType
+	}{
+		"x": {0, 8, symbolic.Continuous},
+		"y": {-1, 3, symbolic.Continuous},
+		"z": {-inf, inf, symbolic.Continuous},
+		"w": {2, inf, symbolic.Integer},
+		"b": {0, 1, symbolic.Binary},
+	}
+	for name, bounds := range expected {
+		v, err := model.Variable(name)
+		if err != nil {
+			t.Errorf("Expected the variable %v to exist, but got: %v", name, err)
+			continue
+		}
+
+		if v.Lower != bounds.lower || v.Upper != bounds.upper || v.Type != bounds.vtype {
+			t.Errorf(
+				"Expected %v to be a %v variable in [%v, %v], but got a %v variable in [%v, %v]",
+				name, bounds.vtype, bounds.lower, bounds.upper, v.Type, v.Lower, v.Upper,
+			)
+		}
+	}
+}
+
+/*
+TestRead3
+Description:
+
+	Verifies that the LP versions of the example problems in utils/examples
+	have the same solutions as the Go versions.
+*/
+func TestRead3(t *testing.T) {
+	// Setup
+	goProblems := map[int]*problem.OptimizationProblem{
+		3: examples.GetTestProblem3(),
+		4: examples.GetTestProblem4(),
+		5: examples.GetTestProblem5(),
+	}
+
+	for ii, goProblem := range goProblems {
+		model, err := lp_format.ReadFile(fmt.Sprintf("testdata/problem%v.lp", ii))
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+
+		if model.Problem.Name != goProblem.Name {
+			t.Errorf("Expected the problem name %v, but got %v", goProblem.Name, model.Problem.Name)
+		}
+
+		// Solve both problems
+		solver := simplexSolver.New("LP Test")
+		expectedSolution, err := solver.Solve(*goProblem)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+
+		solution, err := solver.Solve(*model.Problem)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+
+		// Compare the values (the variables are named x1, x2, ... in the LP files)
+		values := model.ValuesByName(solution.VariableValues)
+		for jj, v := range goProblem.Variables {
+			name := fmt.Sprintf("x%v", jj+1)
+			if math.Abs(values[name]-expectedSolution.VariableValues[v.ID]) > 1e-8 {
+				t.Errorf(
+					"Expected %v = %v in problem %v, but got %v",
+					name, expectedSolution.VariableValues[v.ID], ii, values[name],
+				)
+			}
+		}
+	}
+}
+
+/*
+TestRead4
+Description:
+
+	Verifies that syntax errors are reported with their line numbers.
+*/
+func TestRead4(t *testing.T) {
+	// Setup
+	testCases := []struct {
+		input string
+		line  int
+	}{
+		{"Minimize\n obj: x\nSubject To\n c1: x^2 <= 1\nEnd\n", 4},
+		{"Minimize\n obj: x\nSubject To\n c1: x + y 4\nEnd\n", 4},
+		{"Minimize\n obj: x\nSubject To\n c1: x <= 1\n", 4},
+		{"Minimize\n obj: x\nBounds\n x\nEnd\n", 4},
+	}
+
+	for _, tc := range testCases {
+		// Test
+		_, err := lp_format.Read(strings.NewReader(tc.input))
+
+		// Verify
+		var parseErr lp_format.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Expected a ParseError for %q, but got: %v", tc.input, err)
+			continue
+		}
+
+		if parseErr.Line != tc.line {
+			t.Errorf("Expected the error for %q to be on line %v, but got line %v (%v)", tc.input, tc.line, parseErr.Line, parseErr)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package lp_format_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+	lp_format "github.com/MatProGo-dev/simplex/formats/lp"
+	mps_format "github.com/MatProGo-dev/simplex/formats/mps"
+)
+
+/*
+writeAndRead
+Description:
+
+	Writes the model to a buffer and reads it back.
+	Returns the model that was read and the text that was written.
+*/
+func writeAndRead(t *testing.T, model *formats.Model) (*formats.Model, string) {
+	var buffer bytes.Buffer
+	err := lp_format.Write(&buffer, model)
+	if err != nil {
+		t.Fatalf("Expected no error while writing, but got: %v", err)
+	}
+	text := buffer.String()
+
+	modelOut, err := lp_format.Read(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("Expected no error while reading, but got: %v\n%v", err, text)
+	}
+
+	return modelOut, text
+}
+
+/*
+TestWrite1
+Description:
+
+	Verifies that the example LP round-trips through Write and Read: the model
+	that is read back has the same constraints, bounds and variable types, and
+	writing it again produces the same text.
+*/
+func TestWrite1(t *testing.T) {
+	// Setup
+	model, err := lp_format.Read(strings.NewReader(exampleLP))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	roundTrip, text := writeAndRead(t, model)
+	_, secondText := writeAndRead(t, roundTrip)
+
+	// Verify
+	if !strings.Contains(text, "range: -2 <= x - y <= 5") {
+		t.Errorf("Expected the ranged constraint to be written on one line, but got:\n%v", text)
+	}
+
+	if text != secondText {
+		t.Errorf("Expected the second write to match the first one, but got:\n%v\nand\n%v", text, secondText)
+	}
+
+	if strings.Join(roundTrip.ConstraintNames, ",") != strings.Join(model.ConstraintNames, ",") {
+		t.Fatalf("Expected the constraints %v, but got %v", model.ConstraintNames, roundTrip.ConstraintNames)
+	}
+
+	for ii := range model.Problem.Constraints {
+		expected := model.Problem.Constraints[ii].(symbolic.ScalarConstraint).String()
+		got := roundTrip.Problem.Constraints[ii].(symbolic.ScalarConstraint).String()
+		if expected != got {
+			t.Errorf("Expected constraint %v to be %v, but got %v", ii, expected, got)
+		}
+	}
+
+	for name := range model.VariableIDs {
+		original, _ := model.Variable(name)
+		v, err := roundTrip.Variable(name)
+		if err != nil {
+			t.Errorf("Expected the variable %v to exist, but got: %v", name, err)
+			continue
+		}
+		if v.Lower != original.Lower || v.Upper != original.Upper || v.Type != original.Type {
+			t.Errorf(
+				"Expected %v to be a %v variable in [%v, %v], but got a %v variable in [%v, %v]",
+				name, original.Type, original.Lower, original.Upper, v.Type, v.Lower, v.Upper,
+			)
+		}
+	}
+}
+
+/*
+TestWrite2
+Description:
+
+	Verifies that a model read from MPS can be written as LP and that the
+	LP model has the same constraints and objective constant.
+*/
+func TestWrite2(t *testing.T) {
+	// Setup
+	model, err := mps_format.ReadFile("../mps/testdata/example_fixed.mps", mps_format.Fixed)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	lpModel, _ := writeAndRead(t, model)
+
+	// Verify
+	if strings.Join(lpModel.ConstraintNames, ",") != strings.Join(model.ConstraintNames, ",") {
+		t.Fatalf("Expected the constraints %v, but got %v", model.ConstraintNames, lpModel.ConstraintNames)
+	}
+
+	for ii := range model.Problem.Constraints {
+		expected := model.Problem.Constraints[ii].(symbolic.ScalarConstraint).String()
+		got := lpModel.Problem.Constraints[ii].(symbolic.ScalarConstraint).String()
+		if expected != got {
+			t.Errorf("Expected constraint %v to be %v, but got %v", ii, expected, got)
+		}
+	}
+
+	constant := lpModel.Problem.Objective.Expression.(symbolic.ScalarExpression).Constant()
+	if constant != 3.5 {
+		t.Errorf("Expected the objective constant to be 3.5, but got %v", constant)
+	}
+}
+
+/*
+TestWrite3
+Description:
+
+	Verifies that names that cannot be read back are rejected.
+*/
+func TestWrite3(t *testing.T) {
+	for _, name := range []string{"2x", "x+y", "free", "End"} {
+		// Setup
+		model := formats.NewModel("BAD")
+		_, err := model.AddVariable(name, 0.0, 1.0, symbolic.Continuous)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+
+		// Test
+		var buffer bytes.Buffer
+		err = lp_format.Write(&buffer, model)
+
+		// Verify
+		if err == nil {
+			t.Errorf("Expected an error for the name %q, but got none", name)
+		}
+	}
+}
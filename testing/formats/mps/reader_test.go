@@ -0,0 +1,268 @@
+package mps_format_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	mps_format "github.com/MatProGo-dev/simplex/formats/mps"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+)
+
+/*
+TestRead1
+Description:
+
+	Verifies that the fixed MPS example is parsed into the expected variables,
+	bounds, objective and constraints (including the explicit bound constraints
+	and the two halves of the ranged row R4).
+*/
+func TestRead1(t *testing.T) {
+	// Setup
+	model, err := mps_format.ReadFile("testdata/example_fixed.mps", mps_format.Fixed)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify the problem
+	if model.Problem.Name != "TESTLP" {
+		t.Errorf("Expected the problem name TESTLP, but got %v", model.Problem.Name)
+	}
+
+	if model.Problem.Objective.Sense != problem.SenseMinimize {
+		t.Errorf("Expected a minimization problem, but got %v", model.Problem.Objective.Sense)
+	}
+
+	objective := model.Problem.Objective.Expression.(symbolic.ScalarExpression)
+	if objective.Constant() != 3.5 {
+		t.Errorf("Expected the objective constant to be 3.5, but got %v", objective.Constant())
+	}
+
+	// Verify the variables
+	expectedBounds := map[string][2]float64{
+		"X1": {0, 4},
+		"X2": {-1, 1},
+		"X3": {0, symbolic.Infinity.Constant()},
+		"X4": {-symbolic.Infinity.Constant(), symbolic.Infinity.Constant()},
+	}
+	for name, bounds := range expectedBounds {
+		v, err := model.Variable(name)
+		if err != nil {
+			t.Errorf("Expected the variable %v to exist, but got: %v", name, err)
+			continue
+		}
+
+		if v.Lower != bounds[0] || v.Upper != bounds[1] {
+			t.Errorf("Expected %v to have bounds %v, but got [%v, %v]", name, bounds, v.Lower, v.Upper)
+		}
+	}
+
+	// Verify the constraints
+	expectedNames := []string{"LIM1", "LIM2", "MYEQN", "R4", "R4", "X1_ub", "X2_lb", "X2_ub"}
+	if strings.Join(model.ConstraintNames, ",") != strings.Join(expectedNames, ",") {
+		t.Fatalf("Expected the constraints %v, but got %v", expectedNames, model.ConstraintNames)
+	}
+
+	expectedSenses := []symbolic.ConstrSense{
+		symbolic.SenseLessThanEqual,
+		symbolic.SenseGreaterThanEqual,
+		symbolic.SenseEqual,
+		symbolic.SenseGreaterThanEqual,
+		symbolic.SenseLessThanEqual,
+		symbolic.SenseLessThanEqual,
+		symbolic.SenseGreaterThanEqual,
+		symbolic.SenseLessThanEqual,
+	}
+	for ii, constraint := range model.Problem.Constraints {
+		if constraint.ConstrSense() != expectedSenses[ii] {
+			t.Errorf("Expected constraint %v to have sense %v, but got %v", ii, expectedSenses[ii], constraint.ConstrSense())
+		}
+	}
+
+	// The ranged row R4 (G, rhs 2, range 3) should be 2 <= X4 <= 5
+	upperR4 := model.Problem.Constraints[4].Right().(symbolic.ScalarExpression).Constant()
+	if upperR4 != 5.0 {
+		t.Errorf("Expected the upper limit of R4 to be 5, but got %v", upperR4)
+	}
+}
+
+/*
+TestRead2
+Description:
+
+	Verifies that the free MPS version of the example (with OBJSENSE MAX) is parsed
+	into the same constraints as the fixed version.
+*/
+func TestRead2(t *testing.T) {
+	// Setup
+	fixedModel, err := mps_format.ReadFile("testdata/example_fixed.mps", mps_format.Fixed)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	freeModel, err := mps_format.ReadFile("testdata/example_free.mps", mps_format.Free)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if freeModel.Problem.Objective.Sense != problem.SenseMaximize {
+		t.Errorf("Expected a maximization problem, but got %v", freeModel.Problem.Objective.Sense)
+	}
+
+	if len(freeModel.Problem.Constraints) != len(fixedModel.Problem.Constraints) {
+		t.Fatalf(
+			"Expected %v constraints, but got %v",
+			len(fixedModel.Problem.Constraints),
+			len(freeModel.Problem.Constraints),
+		)
+	}
+
+	for ii := range fixedModel.Problem.Constraints {
+		fixedString := fixedModel.Problem.Constraints[ii].(symbolic.ScalarConstraint).String()
+		freeString := freeModel.Problem.Constraints[ii].(symbolic.ScalarConstraint).String()
+		if fixedString != freeString {
+			t.Errorf("Expected constraint %v to be %v, but got %v", ii, fixedString, freeString)
+		}
+	}
+}
+
+/*
+TestRead3
+Description:
+
+	Verifies that a model read from MPS can be solved by the SimplexSolver and that
+	the solution can be reported by name.
+*/
+func TestRead3(t *testing.T) {
+	// Setup
+	model, err := mps_format.ReadFile("testdata/problem5.mps", mps_format.Free)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Solve
+	solver := simplexSolver.New("MPS Test")
+	sol, err := solver.Solve(*model.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	values := model.ValuesByName(sol.VariableValues)
+	if math.Abs(values["X1"]-125.0) > 1e-8 || math.Abs(values["X2"]-300.0) > 1e-8 {
+		t.Errorf("Expected X1 = 125 and X2 = 300, but got %v", values)
+	}
+}
+
+/*
+TestRead4
+Description:
+
+	Verifies that a reference to an unknown row is reported with its line number.
+*/
+func TestRead4(t *testing.T) {
+	// Setup
+	input := strings.Join([]string{
+		"NAME BAD",
+		"ROWS",
+		" N COST",
+		"COLUMNS",
+		" X1 COST 1.0 MISSING 2.0",
+		"ENDATA",
+	}, "\n")
+
+	// Test
+	_, err := mps_format.Read(strings.NewReader(input), mps_format.Free)
+
+	// Verify
+	var parseErr mps_format.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("Expected a ParseError, but got: %v", err)
+	}
+
+	if parseErr.Line != 5 {
+		t.Errorf("Expected the error to be on line 5, but got line %v", parseErr.Line)
+	}
+}
+
+/*
+TestRead5
+Description:
+
+	Verifies that a negative UP bound makes a variable unbounded below only if no
+	lower bound was given: after an explicit LO 0, the bounds [0, -1] are rejected.
+*/
+func TestRead5(t *testing.T) {
+	// Setup
+	lines := []string{
+		"NAME NEGATIVE",
+		"ROWS",
+		" N COST",
+		" L LIM",
+		"COLUMNS",
+		" X1 COST 1.0 LIM 1.0",
+		"RHS",
+		" RHS LIM 4.0",
+		"BOUNDS",
+		"",
+		" UP BND X1 -1.0",
+		"ENDATA",
+	}
+
+	// Test
+	model, errWithoutLower := mps_format.Read(strings.NewReader(strings.Join(lines, "\n")), mps_format.Free)
+	lines[9] = " LO BND X1 0.0"
+	_, errWithLower := mps_format.Read(strings.NewReader(strings.Join(lines, "\n")), mps_format.Free)
+
+	// Verify
+	if errWithoutLower != nil {
+		t.Fatalf("Expected no error, but got: %v", errWithoutLower)
+	}
+	x1, err := model.Variable("X1")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if x1.Lower != -symbolic.Infinity.Constant() || x1.Upper != -1.0 {
+		t.Errorf("Expected X1 to be unbounded below (with the upper bound -1), but got [%v, %v]", x1.Lower, x1.Upper)
+	}
+
+	if errWithLower == nil || !strings.Contains(errWithLower.Error(), "lower bound 0") {
+		t.Errorf("Expected an error for the bounds [0, -1] of X1, but got: %v", errWithLower)
+	}
+}
+
+/*
+TestRead6
+Description:
+
+	Verifies that FX bounds are rejected with an error naming the variable,
+	because a fixed variable needs an equality constraint.
+*/
+func TestRead6(t *testing.T) {
+	// Setup
+	input := strings.Join([]string{
+		"NAME FIXED",
+		"ROWS",
+		" N COST",
+		" L LIM",
+		"COLUMNS",
+		" X1 COST 1.0 LIM 1.0",
+		"RHS",
+		" RHS LIM 4.0",
+		"BOUNDS",
+		" FX BND X1 3.0",
+		"ENDATA",
+	}, "\n")
+
+	// Test
+	_, err := mps_format.Read(strings.NewReader(input), mps_format.Free)
+
+	// Verify
+	if err == nil || !strings.Contains(err.Error(), "\"X1\" is fixed at 3") {
+		t.Errorf("Expected an error for X1 fixed at 3, but got: %v", err)
+	}
+}
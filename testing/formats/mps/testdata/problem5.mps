@@ -0,0 +1,18 @@
+* Test problem 5 from utils/examples (maximize 15 x1 + 25 x2)
+NAME PROBLEM5
+OBJSENSE MAX
+ROWS
+ N PROFIT
+ L C1
+ L C2
+ L C3
+ L C4
+COLUMNS
+ X1 PROFIT 15 C1 1
+ X1 C3 4 C4 1
+ X2 PROFIT 25 C1 1
+ X2 C2 1 C3 5
+RHS
+ C1 450 C2 300
+ C3 2000 C4 350
+ENDATA
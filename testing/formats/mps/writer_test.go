@@ -0,0 +1,266 @@
+package mps_format_test
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/formats"
+	mps_format "github.com/MatProGo-dev/simplex/formats/mps"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+	"github.com/MatProGo-dev/simplex/utils"
+)
+
+/*
+writeAndRead
+Description:
+
+	Writes the model to a buffer in the given format and reads it back.
+	Returns the model that was read and the text that was written.
+*/
+func writeAndRead(t *testing.T, model *formats.Model, format mps_format.Format) (*formats.Model, string) {
+	var buffer bytes.Buffer
+	err := mps_format.Write(&buffer, model, format)
+	if err != nil {
+		t.Fatalf("Expected no error while writing, but got: %v", err)
+	}
+	text := buffer.String()
+
+	modelOut, err := mps_format.Read(strings.NewReader(text), format)
+	if err != nil {
+		t.Fatalf("Expected no error while reading, but got: %v\n%v", err, text)
+	}
+
+	return modelOut, text
+}
+
+/*
+TestWrite1
+Description:
+
+	Verifies that the fixed MPS example round-trips through Write and Read:
+	the bounds, the objective constant, the ranged row R4 and all of the
+	constraints of the model that is read back match the original.
+*/
+func TestWrite1(t *testing.T) {
+	// Setup
+	model, err := mps_format.ReadFile("testdata/example_fixed.mps", mps_format.Fixed)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	roundTrip, text := writeAndRead(t, model, mps_format.Fixed)
+
+	// Verify
+	if !strings.Contains(text, "RANGES") {
+		t.Errorf("Expected the ranged row to be written in a RANGES section, but got:\n%v", text)
+	}
+
+	if strings.Join(roundTrip.ConstraintNames, ",") != strings.Join(model.ConstraintNames, ",") {
+		t.Fatalf("Expected the constraints %v, but got %v", model.ConstraintNames, roundTrip.ConstraintNames)
+	}
+
+	for ii := range model.Problem.Constraints {
+		expected := model.Problem.Constraints[ii].(symbolic.ScalarConstraint).String()
+		got := roundTrip.Problem.Constraints[ii].(symbolic.ScalarConstraint).String()
+		if expected != got {
+			t.Errorf("Expected constraint %v to be %v, but got %v", ii, expected, got)
+		}
+	}
+
+	for name := range model.VariableIDs {
+		original, _ := model.Variable(name)
+		v, err := roundTrip.Variable(name)
+		if err != nil {
+			t.Errorf("Expected the variable %v to exist, but got: %v", name, err)
+			continue
+		}
+		if v.Lower != original.Lower || v.Upper != original.Upper {
+			t.Errorf(
+				"Expected %v to have bounds [%v, %v], but got [%v, %v]",
+				name, original.Lower, original.Upper, v.Lower, v.Upper,
+			)
+		}
+	}
+
+	constant := roundTrip.Problem.Objective.Expression.(symbolic.ScalarExpression).Constant()
+	if constant != 3.5 {
+		t.Errorf("Expected the objective constant to be 3.5, but got %v", constant)
+	}
+}
+
+/*
+TestWrite2
+Description:
+
+	Verifies that the objective sense of the free MPS example is preserved and
+	that writing the model that was read back produces the same text.
+*/
+func TestWrite2(t *testing.T) {
+	// Setup
+	model, err := mps_format.ReadFile("testdata/example_free.mps", mps_format.Free)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	roundTrip, text := writeAndRead(t, model, mps_format.Free)
+	_, secondText := writeAndRead(t, roundTrip, mps_format.Free)
+
+	// Verify
+	if roundTrip.Problem.Objective.Sense != problem.SenseMaximize {
+		t.Errorf("Expected a maximization problem, but got %v", roundTrip.Problem.Objective.Sense)
+	}
+
+	if text != secondText {
+		t.Errorf("Expected the second write to match the first one, but got:\n%v\nand\n%v", text, secondText)
+	}
+}
+
+/*
+TestWrite3
+Description:
+
+	Verifies that a problem without names can be written with WriteProblem and
+	that the problem read back has the same optimal solution.
+*/
+func TestWrite3(t *testing.T) {
+	// Setup
+	model, err := mps_format.ReadFile("testdata/problem5.mps", mps_format.Free)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	model.Problem.Variables[0].Name = ""
+
+	var buffer bytes.Buffer
+	err = mps_format.WriteProblem(&buffer, model.Problem, mps_format.Free)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	roundTrip, err := mps_format.Read(&buffer, mps_format.Free)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Solve
+	solver := simplexSolver.New("MPS Writer Test")
+	sol, err := solver.Solve(*roundTrip.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	values := map[string]float64{}
+	for ii, name := range roundTrip.VariableNames() {
+		values[name] = sol.VariableValues[roundTrip.Problem.Variables[ii].ID]
+	}
+	if len(values) != 2 {
+		t.Fatalf("Expected 2 variables, but got %v", values)
+	}
+
+	// The unnamed variable is named after its ID
+	for name, value := range values {
+		expected := 300.0
+		if strings.HasPrefix(name, "C") {
+			expected = 125.0
+		}
+		if math.Abs(value-expected) > 1e-8 {
+			t.Errorf("Expected %v = %v, but got %v", name, expected, value)
+		}
+	}
+}
+
+/*
+TestWriteTableau1
+Description:
+
+	Verifies that the standard form of a problem can be exported with WriteTableau:
+	the model read back has one nonnegative variable per tableau column, one equality
+	row per tableau row and the objective row of the tableau as its objective.
+*/
+func TestWriteTableau1(t *testing.T) {
+	// Setup
+	model, err := mps_format.ReadFile("testdata/problem5.mps", mps_format.Free)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	tableau, _, err := utils.GetInitialTableauFrom(model.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err = mps_format.WriteTableau(&buffer, tableau, "PROBLEM5_SF", mps_format.Free)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	standardForm, err := mps_format.Read(&buffer, mps_format.Free)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if len(standardForm.Problem.Variables) != len(tableau.Variables) {
+		t.Fatalf("Expected %v variables, but got %v", len(tableau.Variables), len(standardForm.Problem.Variables))
+	}
+
+	if len(standardForm.Problem.Constraints) != tableau.NumberOfConstraints() {
+		t.Fatalf("Expected %v constraints, but got %v", tableau.NumberOfConstraints(), len(standardForm.Problem.Constraints))
+	}
+
+	for _, constraint := range standardForm.Problem.Constraints {
+		if constraint.ConstrSense() != symbolic.SenseEqual {
+			t.Errorf("Expected only equality constraints, but got %v", constraint)
+		}
+	}
+
+	for _, v := range tableau.Variables {
+		name := strings.ReplaceAll(v.Name, " ", "_")
+		if _, err := standardForm.Variable(name); err != nil {
+			t.Errorf("Expected the variable %v to exist, but got: %v", name, err)
+		}
+	}
+
+	objective, _, err := standardForm.LinearObjective()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	c := tableau.C()
+	for ii, coeff := range objective {
+		if coeff != c.AtVec(ii) {
+			t.Errorf("Expected objective coefficient %v to be %v, but got %v", ii, c.AtVec(ii), coeff)
+		}
+	}
+}
+
+/*
+TestWrite4
+Description:
+
+	Verifies that names longer than 8 characters are rejected by the fixed format.
+*/
+func TestWrite4(t *testing.T) {
+	// Setup
+	model := formats.NewModel("LONG")
+	_, err := model.AddVariable("AVeryLongName", 0.0, 1.0, symbolic.Continuous)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err = mps_format.Write(&buffer, model, mps_format.Fixed)
+
+	// Verify
+	if err == nil || !strings.Contains(err.Error(), "AVeryLongName") {
+		t.Errorf("Expected an error about the name AVeryLongName, but got: %v", err)
+	}
+}
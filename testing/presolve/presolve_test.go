@@ -0,0 +1,306 @@
+package presolve_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/algorithms"
+	"github.com/MatProGo-dev/simplex/presolve"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+checkSlice
+Description:
+
+	Verifies that two slices have the same length and entries (up to 1e-8).
+*/
+func checkSlice(t *testing.T, name string, actual, expected []float64) {
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %v %v, but got %v", name, expected, actual)
+	}
+	for ii := range expected {
+		if math.Abs(actual[ii]-expected[ii]) > 1e-8 {
+			t.Errorf("Expected %v[%v] = %v, but got %v", name, ii, expected[ii], actual[ii])
+		}
+	}
+}
+
+/*
+getPresolveProblem1
+Description:
+
+	Creates the problem
+		maximize   x1 + 2 x2 + f + z - w
+		subject to f >= 2, f <= 2, x1 >= 0, x2 >= 0,
+		           x1 + x2 <= 4, 2 x1 + 2 x2 <= 10, x1 + x2 + f <= 7,
+		           z + x1 == 1, w + f <= 4
+	where x1, x2 and z are free, f >= 0 and 0 <= w <= 1, so that every kind of
+	reduction is used. Its optimal solution is (x1, x2, f, z, w) = (0, 4, 2, 1, 0).
+*/
+func getPresolveProblem1() *problem.OptimizationProblem {
+	// Setup
+	prob := problem.NewProblem("PresolveProblem1")
+	inf := symbolic.Infinity.Constant()
+
+	x := prob.AddVariableVector(2)
+	x1, x2 := x.AtVec(0).(symbolic.Variable), x.AtVec(1).(symbolic.Variable)
+	f := prob.AddVariableClassic(0, inf, symbolic.Continuous)
+	z := prob.AddVariableClassic(-inf, inf, symbolic.Continuous)
+	w := prob.AddVariableClassic(0, 1, symbolic.Continuous)
+
+	// Objective and constraints
+	prob.SetObjective(x1.Plus(x2.Multiply(2.0)).Plus(f).Plus(z).Minus(w), problem.SenseMaximize)
+	prob.Constraints = append(prob.Constraints,
+		f.GreaterEq(2.0),
+		f.LessEq(2.0),
+		x1.GreaterEq(0.0),
+		x2.GreaterEq(0.0),
+		x1.Plus(x2).LessEq(4.0),
+		x1.Multiply(2.0).Plus(x2.Multiply(2.0)).LessEq(10.0),
+		x1.Plus(x2).Plus(f).LessEq(7.0),
+		z.Plus(x1).Eq(1.0),
+		w.Plus(f).LessEq(4.0),
+	)
+
+	return prob
+}
+
+/*
+TestPresolve1
+Description:
+
+	Verifies that solving test problem 5 with presolve turns its four singleton rows
+	into bounds and gives the same solution and dual values as solving it directly
+	(with the pivot counts of the reduced solve).
+*/
+func TestPresolve1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	solver := simplexSolver.New("Presolve Test")
+	solver.Presolve = true
+
+	// Test
+	sol, err := solver.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Status != solution_status.OPTIMAL {
+		t.Fatalf("Expected status OPTIMAL, but got %v", sol.Status)
+	}
+	checkSlice(
+		t, "x",
+		[]float64{sol.VariableValues[prob.Variables[0].ID], sol.VariableValues[prob.Variables[1].ID]},
+		[]float64{125, 300},
+	)
+	checkSlice(t, "y", sol.DualValues, []float64{0, 6.25, 3.75, 0, 0, 0})
+
+	stats := sol.Statistics.Presolve
+	if stats == nil {
+		t.Fatalf("Expected presolve statistics, but got none")
+	}
+	if stats.SingletonRows != 4 || stats.RowsRemoved() != 4 || stats.ColumnsRemoved() != 0 {
+		t.Errorf("Expected 4 singleton rows to be removed, but got %+v", *stats)
+	}
+	if sol.Statistics.Pivots() != sol.Iterations || sol.Statistics.TotalTime <= 0 {
+		t.Errorf("Expected the statistics of the reduced solve, but got %+v", sol.Statistics)
+	}
+}
+
+/*
+TestPresolve2
+Description:
+
+	Verifies the reductions made on a problem that uses every kind of reduction
+	and that postsolve restores the primal values, dual values, reduced costs and
+	a basis with one basic entry per constraint.
+*/
+func TestPresolve2(t *testing.T) {
+	// Setup
+	prob := getPresolveProblem1()
+
+	// Test
+	result, err := presolve.Presolve(prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify the reductions
+	expected := simplex_solution.PresolveStatistics{
+		RowsBefore: 9, ColumnsBefore: 5, RowsAfter: 1, ColumnsAfter: 2,
+		SingletonRows: 4, RedundantRows: 1, DuplicateRows: 2,
+		EmptyColumns: 1, FixedColumns: 1, SingletonColumns: 1,
+	}
+	if result.Statistics != expected {
+		t.Errorf("Expected statistics %+v, but got %+v", expected, result.Statistics)
+	}
+	if result.Status != solution_status.LOADED || result.Problem == nil || len(result.Problem.Constraints) != 1 {
+		t.Fatalf("Expected a reduced problem with a single constraint, but got %+v", result)
+	}
+
+	// Solve the reduced problem and postsolve
+	solver := simplexSolver.New("Presolve Test")
+	reduced, err := solver.Solve(*result.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	sol, err := result.Postsolve(reduced)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify the solution
+	values := make([]float64, len(prob.Variables))
+	reducedCosts := make([]float64, len(prob.Variables))
+	nBasic := 0
+	for jj, v := range prob.Variables {
+		values[jj], reducedCosts[jj] = sol.VariableValues[v.ID], sol.ReducedCosts[v.ID]
+		if sol.Basis.VariableStatus[v.ID] == simplex_solution.BasisStatusBasic {
+			nBasic++
+		}
+	}
+	checkSlice(t, "x", values, []float64{0, 4, 2, 1, 0})
+	checkSlice(t, "y", sol.DualValues, []float64{0, 1, -2, 0, 2, 0, 0, 1, 0})
+	checkSlice(t, "d", reducedCosts, []float64{0, 0, 0, 0, -1})
+
+	if value := sol.GetOptimalValue(); math.Abs(value-11) > 1e-8 {
+		t.Errorf("Expected the optimal value 11, but got %v", value)
+	}
+
+	for _, status := range sol.Basis.ConstraintStatus {
+		if status == simplex_solution.BasisStatusBasic {
+			nBasic++
+		}
+	}
+	if nBasic != len(sol.Basis.ConstraintStatus) {
+		t.Errorf("Expected %v basic entries, but got %v in %+v", len(sol.Basis.ConstraintStatus), nBasic, sol.Basis)
+	}
+}
+
+/*
+TestPresolve3
+Description:
+
+	Verifies that presolve detects an infeasible problem (crossing singleton rows) and an
+	unbounded variable that appears in no constraint without running the algorithm.
+*/
+func TestPresolve3(t *testing.T) {
+	// Setup
+	infeasible := problem.NewProblem("Infeasible")
+	x := infeasible.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	y := infeasible.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	infeasible.SetObjective(x.Plus(y), problem.SenseMaximize)
+	infeasible.Constraints = append(infeasible.Constraints, x.Plus(y).LessEq(3.0), x.GreaterEq(1.0), x.LessEq(-1.0))
+
+	unbounded := problem.NewProblem("Unbounded")
+	u := unbounded.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	v := unbounded.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	unbounded.SetObjective(u.Plus(v), problem.SenseMaximize)
+	unbounded.Constraints = append(unbounded.Constraints, u.LessEq(3.0))
+
+	solver := simplexSolver.New("Presolve Test")
+	solver.Presolve = true
+
+	// Test
+	for _, tc := range []struct {
+		prob   *problem.OptimizationProblem
+		status solution_status.SolutionStatus
+	}{
+		{infeasible, solution_status.INFEASIBLE},
+		{unbounded, solution_status.INF_OR_UNBD},
+	} {
+		sol, err := solver.Solve(*tc.prob)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+
+		// Verify
+		if sol.Status != tc.status || sol.Iterations != 0 || sol.VariableValues != nil {
+			t.Errorf("Expected status %v for %v, but got %+v", tc.status, tc.prob.Name, sol)
+		}
+	}
+}
+
+/*
+TestPresolve4
+Description:
+
+	Verifies that a problem whose variables are all removed is solved by presolve
+	(with the dual value of the constraint that bounds the objective) and that
+	nonlinear problems are rejected.
+*/
+func TestPresolve4(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("Solved By Presolve")
+	x := prob.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	prob.SetObjective(x.Multiply(2.0), problem.SenseMaximize)
+	prob.Constraints = append(prob.Constraints, x.LessEq(3.0), x.GreaterEq(0.0))
+
+	// Test
+	result, err := presolve.Presolve(prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	sol, err := result.Postsolve(simplex_solution.SimplexSolution{})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if result.Problem != nil || sol.Status != solution_status.OPTIMAL {
+		t.Fatalf("Expected presolve to solve the problem, but got %+v", result)
+	}
+	checkSlice(t, "x", []float64{sol.VariableValues[x.ID]}, []float64{3})
+	checkSlice(t, "y", sol.DualValues, []float64{2, 0})
+
+	// Nonlinear problems
+	quadratic := problem.NewProblem("Quadratic")
+	q := quadratic.AddVariableVector(1)
+	quadratic.SetObjective(q.Transpose().Multiply(q), problem.SenseMinimize)
+	if _, err := presolve.Presolve(quadratic); err == nil {
+		t.Errorf("Expected an error for a quadratic objective, but got none")
+	}
+}
+
+/*
+TestPresolve5
+Description:
+
+	Verifies that solving a problem whose equality constraint x + y == 4 cannot be
+	removed by presolve (x and y are nonnegative and appear in two rows) returns an
+	error with every algorithm instead of a solution of the reduced problem.
+*/
+func TestPresolve5(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("Equality")
+	x := prob.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	y := prob.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	prob.SetObjective(x.Plus(y.Multiply(2.0)), problem.SenseMaximize)
+	prob.Constraints = append(prob.Constraints, x.Plus(y).Eq(4.0), x.Plus(y.Multiply(3.0)).LessEq(10.0))
+
+	for _, algoType := range []algorithms.AlgorithmType{
+		algorithms.TypeNaiveTableau,
+		algorithms.TypeExactTableau,
+		algorithms.TypeSparseRevised,
+	} {
+		solver := simplexSolver.New("Presolve Test")
+		solver.Algorithm = algoType
+		solver.Presolve = true
+
+		// Test
+		sol, err := solver.Solve(*prob)
+
+		// Verify
+		if err == nil || !strings.Contains(err.Error(), "equality constraints are not supported") {
+			t.Errorf("%v: Expected an error for the equality constraint, but got %v (status %v)", algoType, err, sol.Status)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	json_format "github.com/MatProGo-dev/simplex/formats/json"
+	"github.com/MatProGo-dev/simplex/server"
+)
+
+/*
+solveRequest
+Description:
+
+	Creates a request to /solve for test problem 5 (from the JSON test data)
+	with the given iteration limit.
+*/
+func solveRequest(t *testing.T, iterationLimit int) *http.Request {
+	return solveRequestWith(t, server.SolveRequest{IterationLimit: iterationLimit, TimeLimitMS: 5000})
+}
+
+/*
+solveRequestWith
+Description:
+
+	Creates a request to /solve for test problem 5 (from the JSON test data)
+	with the limits of the given request.
+*/
+func solveRequestWith(t *testing.T, request server.SolveRequest) *http.Request {
+	data, err := os.ReadFile("../formats/json/testdata/problem5.json")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	err = json.Unmarshal(data, &request.Problem)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	return httptest.NewRequest(http.MethodPost, "/solve", bytes.NewReader(body))
+}
+
+/*
+TestServer_Solve1
+Description:
+
+	Verifies that test problem 5 is solved through POST /solve and that the
+	response refers to the variables by name.
+*/
+func TestServer_Solve1(t *testing.T) {
+	// Setup
+	s := server.New(server.Config{Workers: 2})
+	recorder := httptest.NewRecorder()
+
+	// Test
+	s.ServeHTTP(recorder, solveRequest(t, 0))
+
+	// Verify
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Expected status code 200, but got %v: %v", recorder.Code, recorder.Body.String())
+	}
+
+	var doc json_format.SolutionDocument
+	err := json.Unmarshal(recorder.Body.Bytes(), &doc)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if doc.Status != "OPTIMAL" {
+		t.Errorf("Expected status OPTIMAL, but got %v", doc.Status)
+	}
+
+	if math.Abs(doc.Variables["x1"]-125.0) > 1e-8 || math.Abs(doc.Variables["x2"]-300.0) > 1e-8 {
+		t.Errorf("Expected x1 = 125 and x2 = 300, but got %v", doc.Variables)
+	}
+
+	if math.Abs(doc.Objective-9375.0) > 1e-8 {
+		t.Errorf("Expected the objective to be 9375, but got %v", doc.Objective)
+	}
+}
+
+/*
+TestServer_Solve2
+Description:
+
+	Verifies that the iteration limit of the request is enforced.
+*/
+func TestServer_Solve2(t *testing.T) {
+	// Setup
+	s := server.New(server.Config{})
+	recorder := httptest.NewRecorder()
+
+	// Test
+	s.ServeHTTP(recorder, solveRequest(t, 1))
+
+	// Verify
+	var doc json_format.SolutionDocument
+	err := json.Unmarshal(recorder.Body.Bytes(), &doc)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if doc.Status != "ITERATION_LIMIT" || doc.Iterations != 1 {
+		t.Errorf("Expected status ITERATION_LIMIT after 1 iteration, but got %v after %v", doc.Status, doc.Iterations)
+	}
+}
+
+/*
+TestServer_Solve3
+Description:
+
+	Verifies that requests with the wrong method, an invalid body or an
+	invalid problem are rejected with an error message.
+*/
+func TestServer_Solve3(t *testing.T) {
+	// Setup
+	s := server.New(server.Config{})
+	requests := map[*http.Request]int{
+		httptest.NewRequest(http.MethodGet, "/solve", nil):                                                   http.StatusMethodNotAllowed,
+		httptest.NewRequest(http.MethodPost, "/solve", bytes.NewBufferString("{")):                           http.StatusBadRequest,
+		httptest.NewRequest(http.MethodPost, "/solve", bytes.NewBufferString(`{"problem": {"version": 7}}`)): http.StatusBadRequest,
+	}
+
+	for request, expectedCode := range requests {
+		// Test
+		recorder := httptest.NewRecorder()
+		s.ServeHTTP(recorder, request)
+
+		// Verify
+		if recorder.Code != expectedCode {
+			t.Errorf("Expected status code %v, but got %v", expectedCode, recorder.Code)
+		}
+
+		var response server.ErrorResponse
+		err := json.Unmarshal(recorder.Body.Bytes(), &response)
+		if err != nil || response.Error == "" {
+			t.Errorf("Expected an error message, but got: %v", recorder.Body.String())
+		}
+	}
+}
+
+/*
+TestServer_Solve4
+Description:
+
+	Verifies that a time limit too large to be represented as a time.Duration
+	is capped at the server's maximum instead of expiring at once.
+*/
+func TestServer_Solve4(t *testing.T) {
+	// Setup
+	s := server.New(server.Config{})
+	recorder := httptest.NewRecorder()
+
+	// Test
+	s.ServeHTTP(recorder, solveRequestWith(t, server.SolveRequest{TimeLimitMS: 1e13}))
+
+	// Verify
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Expected status code 200, but got %v: %v", recorder.Code, recorder.Body.String())
+	}
+
+	var doc json_format.SolutionDocument
+	err := json.Unmarshal(recorder.Body.Bytes(), &doc)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if doc.Status != "OPTIMAL" {
+		t.Errorf("Expected status OPTIMAL, but got %v", doc.Status)
+	}
+}
+
+/*
+TestServer_Healthz1
+Description:
+
+	Verifies that GET /healthz reports that the server is running.
+*/
+func TestServer_Healthz1(t *testing.T) {
+	// Setup
+	s := server.New(server.Config{})
+	recorder := httptest.NewRecorder()
+
+	// Test
+	s.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	// Verify
+	if recorder.Code != http.StatusOK || !bytes.Contains(recorder.Body.Bytes(), []byte(`"ok"`)) {
+		t.Errorf("Expected status code 200 with status ok, but got %v: %v", recorder.Code, recorder.Body.String())
+	}
+}
+
+/*
+TestServer_Solve5
+Description:
+
+	Verifies that the algorithm named in the request solves the problem and that
+	an unknown algorithm is rejected with 400 Bad Request.
+*/
+func TestServer_Solve5(t *testing.T) {
+	// Setup
+	s := server.New(server.Config{})
+
+	for _, algorithm := range []string{"tableau", "exact-tableau", "sparse-revised"} {
+		// Test
+		recorder := httptest.NewRecorder()
+		s.ServeHTTP(recorder, solveRequestWith(t, server.SolveRequest{Algorithm: algorithm}))
+
+		// Verify
+		var doc json_format.SolutionDocument
+		err := json.Unmarshal(recorder.Body.Bytes(), &doc)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if recorder.Code != http.StatusOK || doc.Status != "OPTIMAL" {
+			t.Errorf("%v: Expected status OPTIMAL, but got %v: %v", algorithm, recorder.Code, recorder.Body.String())
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	s.ServeHTTP(recorder, solveRequestWith(t, server.SolveRequest{Algorithm: "interior-point"}))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code 400 for an unknown algorithm, but got %v", recorder.Code)
+	}
+}
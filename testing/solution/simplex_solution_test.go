@@ -1,11 +1,15 @@
 package solution_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/MatProGo-dev/MatProInterface.go/problem"
 	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
 	simplex_solution "github.com/MatProGo-dev/simplex/solution"
+	"github.com/MatProGo-dev/simplex/utils/examples"
 )
 
 /*
@@ -284,3 +288,92 @@ func TestSimplexSolution_CompleteStructure(t *testing.T) {
 		t.Errorf("Expected %d iterations, but got %d", iterations, sol.Iterations)
 	}
 }
+
+/*
+TestSimplexSolution_EvaluateAtVariableValues1
+Description:
+
+	Verifies that the solution of test problem 5 stores the objective value 9375 and the
+	activity and slack of each of its six scalar constraints.
+*/
+func TestSimplexSolution_EvaluateAtVariableValues1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	solver := simplexSolver.New("Evaluation Test")
+
+	// Test
+	sol, err := solver.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if math.Abs(sol.Objective-9375) > 1e-9 || sol.GetOptimalValue() != sol.Objective {
+		t.Errorf("Expected the objective value 9375, but got %v", sol.Objective)
+	}
+
+	expectedActivities := []float64{425, 300, 2000, 125, 125, 300}
+	expectedSlacks := []float64{25, 0, 0, 225, 125, 300}
+	if len(sol.ConstraintActivities) != 6 || len(sol.ConstraintSlacks) != 6 {
+		t.Fatalf("Expected 6 activities and slacks, but got %v and %v", sol.ConstraintActivities, sol.ConstraintSlacks)
+	}
+	for kk := range expectedActivities {
+		if math.Abs(sol.ConstraintActivities[kk]-expectedActivities[kk]) > 1e-9 {
+			t.Errorf("Expected activity %v of constraint %v, but got %v", expectedActivities[kk], kk, sol.ConstraintActivities[kk])
+		}
+		if math.Abs(sol.ConstraintSlacks[kk]-expectedSlacks[kk]) > 1e-9 {
+			t.Errorf("Expected slack %v of constraint %v, but got %v", expectedSlacks[kk], kk, sol.ConstraintSlacks[kk])
+		}
+	}
+}
+
+/*
+TestSimplexSolution_EvaluateAtVariableValues2
+Description:
+
+	Verifies that the objective includes its constant term for the problem
+		minimize   2 x - 3 y + 7
+		subject to x + y <= 4, 3 <= x + 2 y, x >= 0, y >= 0
+	at (x, y) = (0, 4) and that constants on both sides of a constraint are moved to
+	the right hand side.
+*/
+func TestSimplexSolution_EvaluateAtVariableValues2(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("ConstantProblem")
+	x := prob.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	y := prob.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	prob.SetObjective(x.Multiply(2.0).Minus(y.Multiply(3.0)).Plus(7.0), problem.SenseMinimize)
+	prob.Constraints = append(prob.Constraints,
+		x.Plus(y).LessEq(4.0),
+		symbolic.K(3.0).LessEq(x.Plus(y.Multiply(2.0))),
+		x.Plus(1.0).GreaterEq(1.0),
+		y.GreaterEq(0.0),
+	)
+
+	sol := simplex_solution.SimplexSolution{
+		VariableValues:  map[uint64]float64{x.ID: 0, y.ID: 4},
+		OriginalProblem: prob,
+	}
+
+	// Test
+	err := sol.EvaluateAtVariableValues()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if sol.Objective != -5 {
+		t.Errorf("Expected the objective value -5, but got %v", sol.Objective)
+	}
+
+	expectedActivities := []float64{4, -8, 0, 4}
+	expectedSlacks := []float64{0, 5, 0, 4}
+	for kk := range expectedActivities {
+		if sol.ConstraintActivities[kk] != expectedActivities[kk] || sol.ConstraintSlacks[kk] != expectedSlacks[kk] {
+			t.Errorf(
+				"Expected activity %v and slack %v of constraint %v, but got %v and %v",
+				expectedActivities[kk], expectedSlacks[kk], kk, sol.ConstraintActivities[kk], sol.ConstraintSlacks[kk],
+			)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package solution_test
+
+import (
+	"math"
+	"testing"
+
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestSimplexSolution_Verify1
+Description:
+
+	Verifies that the solution of test problem 5 satisfies the optimality conditions
+	(with the primal and dual objective values 9375) and that the solver stores the
+	report when VerificationTolerance is set.
+*/
+func TestSimplexSolution_Verify1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	solver := simplexSolver.New("Verification Test")
+	solver.VerificationTolerance = 1e-9
+
+	sol, err := solver.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	report, err := sol.Verify()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if !report.DualsChecked || !report.OptimalWithin(1e-9) {
+		t.Errorf("Expected the solution to be optimal, but got %+v", report)
+	}
+	if math.Abs(report.PrimalObjective-9375) > 1e-9 || math.Abs(report.DualObjective-9375) > 1e-9 {
+		t.Errorf("Expected the primal and dual objective values 9375, but got %+v", report)
+	}
+	if report.WorstConstraint != -1 {
+		t.Errorf("Expected no violated constraint, but got %v", report.WorstConstraint)
+	}
+
+	if sol.Status != solution_status.OPTIMAL || sol.Statistics.Verification == nil {
+		t.Errorf("Expected an OPTIMAL solution with a verification report, but got %+v", sol)
+	}
+}
+
+/*
+TestSimplexSolution_Verify2
+Description:
+
+	Verifies that VerifyAndDowngrade downgrades the status of a solution of test problem 5
+	to NUMERIC when a primal value is changed (violating 4 x1 + 5 x2 <= 2000 by 20)
+	and to SUBOPTIMAL when a dual value has the wrong sign.
+*/
+func TestSimplexSolution_Verify2(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+	solver := simplexSolver.New("Verification Test")
+
+	primal, err := solver.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	primal.VariableValues[prob.Variables[0].ID] = 130
+
+	dual, err := solver.Solve(*prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	dual.DualValues[1] = -6.25
+
+	// Test
+	primalReport, err := primal.VerifyAndDowngrade(1e-6)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	dualReport, err := dual.VerifyAndDowngrade(1e-6)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if primal.Status != solution_status.NUMERIC {
+		t.Errorf("Expected status NUMERIC, but got %v", primal.Status)
+	}
+	if primalReport.WorstConstraint != 2 || math.Abs(primalReport.MaxPrimalViolation-20) > 1e-9 {
+		t.Errorf("Expected constraint 2 to be violated by 20, but got %+v", primalReport)
+	}
+
+	if dual.Status != solution_status.SUBOPTIMAL {
+		t.Errorf("Expected status SUBOPTIMAL, but got %v", dual.Status)
+	}
+	if !dualReport.PrimalFeasibleWithin(1e-9) || math.Abs(dualReport.MaxDualViolation-6.25) > 1e-9 {
+		t.Errorf("Expected a dual violation of 6.25, but got %+v", dualReport)
+	}
+}
@@ -1 +1,238 @@
 package solver_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	solution_status "github.com/MatProGo-dev/MatProInterface.go/solution/status"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+
+	"github.com/MatProGo-dev/simplex/algorithms"
+	tableau_algorithm1 "github.com/MatProGo-dev/simplex/algorithms/tableau"
+	"github.com/MatProGo-dev/simplex/simplexSolver"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestSimplexSolver_CreateAlgorithm1
+Description:
+
+	Verifies that the tableau algorithm is selected by name and that the
+	iteration limit and the tolerances of the solver are passed on to it.
+*/
+func TestSimplexSolver_CreateAlgorithm1(t *testing.T) {
+	// Setup
+	algoType, err := algorithms.ToAlgorithmType("tableau")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	solver := simplexSolver.New("Solver Test")
+	solver.IterationLimit = 7
+	solver.PivotTolerance = 1e-8
+	solver.OptimalityTolerance = 1e-6
+
+	// Test
+	algo, err := solver.CreateAlgorithm(algoType)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	tableauAlgo, ok := algo.(*tableau_algorithm1.TableauAlgorithm)
+	if !ok {
+		t.Fatalf("Expected a *TableauAlgorithm, but got %T", algo)
+	}
+
+	if tableauAlgo.IterationLimit != 7 || tableauAlgo.GetPivotTolerance() != 1e-8 || tableauAlgo.OptimalityTolerance != 1e-6 {
+		t.Errorf("Expected the solver's settings to be used, but got %+v", tableauAlgo)
+	}
+}
+
+/*
+TestToAlgorithmType1
+Description:
+
+	Verifies that unknown algorithm names are rejected.
+*/
+func TestToAlgorithmType1(t *testing.T) {
+	_, err := algorithms.ToAlgorithmType("ellipsoid")
+	if err == nil {
+		t.Errorf("Expected an error for an unknown algorithm, but got none")
+	}
+}
+
+/*
+TestSimplexSolver_SolveBatch1
+Description:
+
+	Solves a batch of copies of test problem 5 (whose objectives are scaled by
+	different factors) together with a quadratic problem on four workers and verifies
+	that the results are returned in input order (i.e., that every copy has the
+	optimal objective of its own scale factor) and that only the quadratic problem
+	has an error.
+*/
+func TestSimplexSolver_SolveBatch1(t *testing.T) {
+	// Setup
+	quadratic := problem.NewProblem("Quadratic")
+	x := quadratic.AddVariableVector(1)
+	err := quadratic.SetObjective(x.AtVec(0).Multiply(x.AtVec(0)), problem.SenseMinimize)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	nProblems := 40
+	badIdx := 17
+	problems := make([]problem.OptimizationProblem, nProblems)
+	for ii := range problems {
+		if ii == badIdx {
+			problems[ii] = *quadratic
+			continue
+		}
+
+		// (scaling the objective by ii + 1 scales the optimal objective 9375 by ii + 1)
+		prob := examples.GetTestProblem5()
+		err = prob.SetObjective(prob.Objective.Expression.Multiply(float64(ii+1)), problem.SenseMaximize)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		problems[ii] = *prob
+	}
+
+	solver := simplexSolver.New("Batch Test")
+	solver.Workers = 4
+
+	// Test
+	results := solver.SolveBatch(context.Background(), problems)
+
+	// Verify
+	if len(results) != nProblems {
+		t.Fatalf("Expected %v results, but got %v", nProblems, len(results))
+	}
+
+	for ii, result := range results {
+		if ii == badIdx {
+			if result.Err == nil {
+				t.Errorf("Expected an error for the quadratic problem, but got none")
+			}
+			continue
+		}
+
+		if result.Err != nil {
+			t.Errorf("Expected no error for problem %v, but got: %v", ii, result.Err)
+			continue
+		}
+
+		expected := 9375.0 * float64(ii+1)
+		if result.Solution.Status != solution_status.OPTIMAL || math.Abs(result.Solution.Objective-expected) > 1e-8*expected {
+			t.Errorf("Expected problem %v to have the objective %v, but got %v (%v)", ii, expected, result.Solution.Objective, result.Solution.Status)
+		}
+
+		x1 := result.Solution.VariableValues[problems[ii].Variables[0].ID]
+		if math.Abs(x1-125.0) > 1e-8 {
+			t.Errorf("Expected problem %v to be solved with x1 = 125, but got %v", ii, x1)
+		}
+	}
+}
+
+/*
+TestSimplexSolver_SolveBatch2
+Description:
+
+	Verifies that no problem is solved once the context has been cancelled.
+*/
+func TestSimplexSolver_SolveBatch2(t *testing.T) {
+	// Setup
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	problems := []problem.OptimizationProblem{*examples.GetTestProblem5(), *examples.GetTestProblem5()}
+	solver := simplexSolver.New("Batch Test")
+
+	// Test
+	results := solver.SolveBatch(ctx, problems)
+
+	// Verify
+	for ii, result := range results {
+		if result.Err != context.Canceled {
+			t.Errorf("Expected problem %v to fail with %v, but got: %v", ii, context.Canceled, result.Err)
+		}
+	}
+}
+
+/*
+TestSimplexSolver_Solve1
+Description:
+
+	Verifies that every algorithm reports the unbounded problem
+		max u + w s.t. u - w <= 1, u, w >= 0
+	with the status UNBOUNDED (and not with an error).
+*/
+func TestSimplexSolver_Solve1(t *testing.T) {
+	// Setup
+	unbounded := problem.NewProblem("Unbounded")
+	u := unbounded.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	w := unbounded.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+	unbounded.SetObjective(u.Plus(w), problem.SenseMaximize)
+	unbounded.Constraints = append(unbounded.Constraints, u.Minus(w).LessEq(1.0))
+
+	for _, algoType := range []algorithms.AlgorithmType{
+		algorithms.TypeNaiveTableau,
+		algorithms.TypeExactTableau,
+		algorithms.TypeSparseRevised,
+	} {
+		// Test
+		solver := simplexSolver.New("Unbounded Test")
+		solver.Algorithm = algoType
+		sol, err := solver.Solve(*unbounded)
+
+		// Verify
+		if err != nil {
+			t.Errorf("%v: Expected no error, but got: %v", algoType, err)
+			continue
+		}
+		if sol.Status != solution_status.UNBOUNDED {
+			t.Errorf("%v: Expected status UNBOUNDED, but got %v", algoType, sol.Status)
+		}
+	}
+}
+
+/*
+TestSimplexSolver_SolveWithHistory1
+Description:
+
+	Verifies that SolveWithHistory returns the solution of the tableau algorithm with
+	its total time and one state per iteration (plus the initial state), and that it
+	returns an error for an algorithm that records no history.
+*/
+func TestSimplexSolver_SolveWithHistory1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem3()
+	solver := simplexSolver.New("History Test")
+	solver.InPlacePivoting = true
+
+	// Test
+	sol, history, err := solver.SolveWithHistory(*prob)
+
+	// Verify
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if sol.Status != solution_status.OPTIMAL {
+		t.Errorf("Expected status OPTIMAL, but got %v", sol.Status)
+	}
+	if sol.Statistics.TotalTime <= 0 {
+		t.Errorf("Expected a positive total time, but got %v", sol.Statistics.TotalTime)
+	}
+	if len(history) != sol.Iterations+1 {
+		t.Errorf("Expected %v states, but got %v", sol.Iterations+1, len(history))
+	}
+
+	solver.Algorithm = algorithms.TypeSparseRevised
+	_, _, err = solver.SolveWithHistory(*prob)
+	if err == nil {
+		t.Errorf("Expected an error for the revised algorithm, but got none")
+	}
+}
@@ -0,0 +1,124 @@
+package utils_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MatProGo-dev/simplex/utils"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+checkRationalRow
+Description:
+
+	Verifies that a row of the rational tableau is equal to the expected fractions.
+*/
+func checkRationalRow(t *testing.T, tableau utils.RationalTableau, ii int, expected []string) {
+	row := tableau.AsCompressedMatrix[ii]
+	if len(row) != len(expected) {
+		t.Fatalf("Expected row %v to have %v entries, but it has %v", ii, len(expected), len(row))
+	}
+	for jj, entry := range row {
+		if entry.RatString() != expected[jj] {
+			t.Errorf("Expected entry (%v,%v) to be %v, but got %v", ii, jj, expected[jj], entry.RatString())
+		}
+	}
+}
+
+/*
+TestRationalTableau_Pivot1
+Description:
+
+	Verifies that pivoting the rational version of tableau example 1 (x2 replaces s2 and
+	then x1 replaces s3) reproduces the exact optimal tableau of the textbook example,
+	whose objective row is [0, 0, 0, 25/4, 15/4, 0 | 9375].
+*/
+func TestRationalTableau_Pivot1(t *testing.T) {
+	// Setup
+	floatTableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	tableau, err := utils.NewRationalTableau(*floatTableau)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	tableau, err = tableau.Pivot(1, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	checkRationalRow(t, tableau, 0, []string{"-15", "0", "0", "25", "0", "0", "7500"})
+
+	tableau, err = tableau.Pivot(0, 4)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	checkRationalRow(t, tableau, 0, []string{"0", "0", "0", "25/4", "15/4", "0", "9375"})
+	checkRationalRow(t, tableau, 1, []string{"0", "0", "1", "1/4", "-1/4", "0", "25"})
+	checkRationalRow(t, tableau, 2, []string{"0", "1", "0", "1", "0", "0", "300"})
+	checkRationalRow(t, tableau, 3, []string{"1", "0", "0", "-5/4", "1/4", "0", "125"})
+	checkRationalRow(t, tableau, 4, []string{"0", "0", "0", "5/4", "-1/4", "1", "225"})
+
+	if !tableau.CanNotBeImproved() {
+		t.Errorf("Expected the final tableau to be optimal")
+	}
+
+	values := tableau.BasicSolution()
+	if values[0].Cmp(big.NewRat(125, 1)) != 0 || values[1].Cmp(big.NewRat(300, 1)) != 0 {
+		t.Errorf("Expected (x1, x2) = (125, 300), but got (%v, %v)", values[0], values[1])
+	}
+
+	// The float64 conversion gives the same tableau as the float64 pivots
+	converted, err := tableau.ToTableau()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if converted.AsCompressedMatrix.At(0, 3) != 6.25 || converted.D() != 9375 {
+		t.Errorf("Expected the converted objective row to contain 6.25 and 9375, but got %v", converted.String())
+	}
+}
+
+/*
+TestRationalTableau_Pivot2
+Description:
+
+	Verifies that invalid pivots are rejected and that floats are converted into
+	the fraction of their shortest decimal representation.
+*/
+func TestRationalTableau_Pivot2(t *testing.T) {
+	// Setup
+	floatTableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	tableau, err := utils.NewRationalTableau(*floatTableau)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	for _, pivot := range [][2]int{
+		{2, 3}, // the entering variable is basic
+		{0, 1}, // the exiting variable is not basic
+		{0, 3}, // the pivot element is zero
+	} {
+		if _, err := tableau.Pivot(pivot[0], pivot[1]); err == nil {
+			t.Errorf("Expected an error for the pivot %v, but got none", pivot)
+		}
+	}
+
+	// Verify the conversion of floats
+	if value := utils.RatFromFloat64(0.1); value.Cmp(big.NewRat(1, 10)) != 0 {
+		t.Errorf("Expected 0.1 to be converted into 1/10, but got %v", value)
+	}
+	if value := utils.RatFromFloat64(-2.5e-3); value.Cmp(big.NewRat(-1, 400)) != 0 {
+		t.Errorf("Expected -2.5e-3 to be converted into -1/400, but got %v", value)
+	}
+}
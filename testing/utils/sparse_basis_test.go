@@ -0,0 +1,109 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/simplex/utils"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+checkSparseBasisAgainstDense
+Description:
+
+	Checks that FTRAN and BTRAN of the basis solve B x = b and B^T y = b, where B is
+	the dense matrix made of the basic columns of A.
+*/
+func checkSparseBasisAgainstDense(t *testing.T, A *utils.CSCMatrix, basis *utils.SparseBasis, b []float64) {
+	t.Helper()
+
+	// Setup
+	dense := A.ToDense()
+	nRows := len(b)
+	B := mat.NewDense(nRows, nRows, nil)
+	for ii, bvIdx := range basis.BasicVariableIndicies {
+		B.SetCol(ii, mat.Col(nil, bvIdx, dense))
+	}
+
+	x := append([]float64{}, b...)
+	basis.FTRAN(x)
+	y := append([]float64{}, b...)
+	basis.BTRAN(y)
+
+	// Verify
+	var Bx, BTy mat.VecDense
+	Bx.MulVec(B, mat.NewVecDense(nRows, x))
+	BTy.MulVec(B.T(), mat.NewVecDense(nRows, y))
+	if !mat.EqualApprox(&Bx, mat.NewVecDense(nRows, b), 1e-10) {
+		t.Errorf("Expected B * FTRAN(b) to be %v, but got %v", b, Bx.RawVector().Data)
+	}
+	if !mat.EqualApprox(&BTy, mat.NewVecDense(nRows, b), 1e-10) {
+		t.Errorf("Expected B^T * BTRAN(b) to be %v, but got %v", b, BTy.RawVector().Data)
+	}
+}
+
+/*
+TestSparseBasis_Replace1
+Description:
+
+	Verifies that FTRAN and BTRAN solve the systems of the basis matrix after the
+	slack basis of a small problem has been changed by two Replace calls, and again
+	after the basis has been refactorized.
+*/
+func TestSparseBasis_Replace1(t *testing.T) {
+	// Setup
+	A := utils.NewCSCMatrixFromDense(mat.NewDense(3, 5, []float64{
+		1.0, 1.0, 1.0, 0.0, 0.0,
+		4.0, 5.0, 0.0, 1.0, 0.0,
+		1.0, 0.0, 0.0, 0.0, 1.0,
+	}))
+	b := []float64{450.0, 2000.0, 350.0}
+	basis, err := utils.NewSparseBasis(&A, []int{2, 3, 4}, 1e-9)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	alpha := make([]float64, 3)
+	basis.ColumnOf(0, alpha)
+	if err = basis.Replace(2, 0, alpha); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	basis.ColumnOf(1, alpha)
+	if err = basis.Replace(1, 1, alpha); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	checkSparseBasisAgainstDense(t, &A, basis, b)
+	if basis.NumberOfEtas() != 2 {
+		t.Errorf("Expected 2 etas, but got %v", basis.NumberOfEtas())
+	}
+
+	if err = basis.Refactorize(); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	checkSparseBasisAgainstDense(t, &A, basis, b)
+}
+
+/*
+TestSparseBasis_Refactorize1
+Description:
+
+	Verifies that a singular basis matrix is reported by NewSparseBasis.
+*/
+func TestSparseBasis_Refactorize1(t *testing.T) {
+	// Setup
+	A := utils.NewCSCMatrixFromDense(mat.NewDense(2, 3, []float64{
+		1.0, 2.0, 1.0,
+		2.0, 4.0, 0.0,
+	}))
+
+	// Test
+	_, err := utils.NewSparseBasis(&A, []int{0, 1}, 1e-9)
+
+	// Verify
+	if err == nil {
+		t.Errorf("Expected an error for a singular basis, but got none")
+	}
+}
@@ -0,0 +1,109 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/simplex/utils"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+TestNewCSCMatrixFromTriplets1
+Description:
+
+	Verifies that NewCSCMatrixFromTriplets adds duplicate entries together, drops
+	zero entries and stores the rows of each column in increasing order.
+*/
+func TestNewCSCMatrixFromTriplets1(t *testing.T) {
+	// Setup
+	rows := []int{2, 0, 1, 0, 2, 1}
+	cols := []int{0, 0, 2, 2, 0, 1}
+	values := []float64{1.0, 3.0, 4.0, 5.0, 2.0, 0.0}
+
+	// Test
+	m, err := utils.NewCSCMatrixFromTriplets(3, 3, rows, cols, values)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if m.NNZ() != 4 {
+		t.Errorf("Expected 4 nonzeros, but got %v", m.NNZ())
+	}
+	expected := mat.NewDense(3, 3, []float64{
+		3.0, 0.0, 5.0,
+		0.0, 0.0, 4.0,
+		3.0, 0.0, 0.0,
+	})
+	if !mat.Equal(m.ToDense(), expected) {
+		t.Errorf("Expected the matrix %v, but got %v", mat.Formatted(expected), mat.Formatted(m.ToDense()))
+	}
+	columnRows, _ := m.Column(0)
+	if len(columnRows) != 2 || columnRows[0] != 0 || columnRows[1] != 2 {
+		t.Errorf("Expected the rows [0 2] in column 0, but got %v", columnRows)
+	}
+}
+
+/*
+TestNewCSCMatrixFromTriplets2
+Description:
+
+	Verifies that NewCSCMatrixFromTriplets returns an error for an entry outside of the matrix.
+*/
+func TestNewCSCMatrixFromTriplets2(t *testing.T) {
+	// Test
+	_, err := utils.NewCSCMatrixFromTriplets(2, 2, []int{0, 2}, []int{0, 1}, []float64{1.0, 1.0})
+
+	// Verify
+	if err == nil {
+		t.Errorf("Expected an error, but got none")
+	}
+}
+
+/*
+TestCSCMatrix_ToCSR1
+Description:
+
+	Verifies that the CSR form of a matrix has the same entries and that the products
+	m * x and m^T * y match the dense products.
+*/
+func TestCSCMatrix_ToCSR1(t *testing.T) {
+	// Setup
+	dense := mat.NewDense(3, 4, []float64{
+		1.0, 0.0, 2.0, 0.0,
+		0.0, 0.0, 3.0, -1.0,
+		4.0, 5.0, 0.0, 0.0,
+	})
+	m := utils.NewCSCMatrixFromDense(dense)
+	x := []float64{1.0, -2.0, 0.5, 3.0}
+	y := []float64{2.0, 0.0, -1.0}
+
+	// Test
+	csr := m.ToCSR()
+
+	// Verify
+	if csr.NNZ() != m.NNZ() {
+		t.Errorf("Expected %v nonzeros, but got %v", m.NNZ(), csr.NNZ())
+	}
+	for ii := 0; ii < 3; ii++ {
+		cols, values := csr.Row(ii)
+		for k, jj := range cols {
+			if values[k] != dense.At(ii, jj) {
+				t.Errorf("Expected the entry (%v, %v) to be %v, but got %v", ii, jj, dense.At(ii, jj), values[k])
+			}
+		}
+	}
+
+	var expectedMx, expectedMTy mat.VecDense
+	expectedMx.MulVec(dense, mat.NewVecDense(4, x))
+	expectedMTy.MulVec(dense.T(), mat.NewVecDense(3, y))
+	for name, pair := range map[string][2][]float64{
+		"CSC m * x":   {m.MulVec(x), expectedMx.RawVector().Data},
+		"CSR m * x":   {csr.MulVec(x), expectedMx.RawVector().Data},
+		"CSR m^T * y": {csr.TMulVec(y), expectedMTy.RawVector().Data},
+	} {
+		if !mat.EqualApprox(mat.NewVecDense(len(pair[0]), pair[0]), mat.NewVecDense(len(pair[1]), pair[1]), 1e-12) {
+			t.Errorf("Expected %v to be %v, but got %v", name, pair[1], pair[0])
+		}
+	}
+}
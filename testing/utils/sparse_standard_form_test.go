@@ -0,0 +1,97 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/simplex/utils"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestGetSparseStandardFormFrom1
+Description:
+
+	Verifies the sparse standard form of test problem 5: the constraints x_i >= 0 rule
+	out the negative parts of both variables and are dropped, so there are 4 rows,
+	2 + 4 columns and the slack basis is feasible (B = b).
+*/
+func TestGetSparseStandardFormFrom1(t *testing.T) {
+	// Setup
+	prob := examples.GetTestProblem5()
+
+	// Test
+	sf, varMap, err := utils.GetSparseStandardFormFrom(prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	nRows, nCols := sf.A.Dims()
+	if nRows != 4 || nCols != 6 {
+		t.Fatalf("Expected a 4 x 6 constraint matrix, but got %v x %v", nRows, nCols)
+	}
+	if sf.A.NNZ() != 6+4 {
+		t.Errorf("Expected 10 nonzeros, but got %v", sf.A.NNZ())
+	}
+	for ii, expected := range []float64{450.0, 300.0, 2000.0, 350.0} {
+		if sf.B[ii] != expected {
+			t.Errorf("Expected B[%v] = %v, but got %v", ii, expected, sf.B[ii])
+		}
+		if sf.A.At(ii, sf.SlackBasis[ii]) != 1.0 {
+			t.Errorf("Expected the slack of row %v to have the coefficient 1, but got %v", ii, sf.A.At(ii, sf.SlackBasis[ii]))
+		}
+	}
+	if sf.C[0] != 15.0 || sf.C[1] != 25.0 {
+		t.Errorf("Expected the objective coefficients (15, 25), but got %v", sf.C[:2])
+	}
+
+	// The original variables are recovered from the standard form variables
+	values, err := sf.ValuesOf([]float64{125.0, 300.0, 25.0, 0.0, 0.0, 225.0}, varMap)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if values[prob.Variables[0].ID] != 125.0 || values[prob.Variables[1].ID] != 300.0 {
+		t.Errorf("Expected the values (125, 300), but got %v", values)
+	}
+}
+
+/*
+TestGetSparseStandardFormFrom2
+Description:
+
+	Verifies that the bounds of the variables and the constraints on a single variable
+	rule out the parts of the variables with the same tolerance on both sides of zero:
+	a in [-5, 0] and b <= 1e-9 (with b free) only have a negative part, and c >= -1e-9
+	(with c free) only has a positive part.
+*/
+func TestGetSparseStandardFormFrom2(t *testing.T) {
+	// Setup
+	prob := problem.NewProblem("SignTolerance")
+	inf := symbolic.Infinity.Constant()
+	a := prob.AddVariableClassic(-5.0, 0.0, symbolic.Continuous)
+	b := prob.AddVariableClassic(-inf, inf, symbolic.Continuous)
+	c := prob.AddVariableClassic(-inf, inf, symbolic.Continuous)
+	prob.SetObjective(a.Plus(b).Minus(c), problem.SenseMinimize)
+	prob.Constraints = append(prob.Constraints, b.LessEq(1e-9), c.GreaterEq(-1e-9))
+
+	// Test
+	sf, _, err := utils.GetSparseStandardFormFrom(prob)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	var parts []string
+	for _, v := range sf.Variables {
+		if !strings.Contains(v.Name, "slack") {
+			parts = append(parts, v.Name)
+		}
+	}
+	expected := []string{a.Name + " (-)", b.Name + " (-)", c.Name + " (+)"}
+	if strings.Join(parts, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected the columns %v, but got %v", expected, parts)
+	}
+}
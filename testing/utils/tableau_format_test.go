@@ -0,0 +1,119 @@
+package utils_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/simplex/utils"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestTableau_String1
+Description:
+
+	Verifies that the plain-text form of the tableau from GetTableauExample1
+	has a header, the objective row, one line per constraint and two separators,
+	and that every line has the same width.
+*/
+func TestTableau_String1(t *testing.T) {
+	// Setup
+	tableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	lines := strings.Split(strings.TrimSuffix(tableau.String(), "\n"), "\n")
+
+	// Verify
+	if len(lines) != 3+1+tableau.NumberOfConstraints() {
+		t.Fatalf("Expected %v lines, but got:\n%v", 4+tableau.NumberOfConstraints(), tableau.String())
+	}
+
+	for _, line := range lines {
+		if len(line) != len(lines[0]) {
+			t.Errorf("Expected all lines to have width %v, but got %q", len(lines[0]), line)
+		}
+	}
+
+	if !strings.HasPrefix(lines[0], "Basis") || !strings.HasSuffix(lines[0], "RHS") {
+		t.Errorf("Expected the header to start with Basis and end with RHS, but got %q", lines[0])
+	}
+
+	if !strings.HasPrefix(lines[2], "z ") || !strings.HasSuffix(lines[2], " 0") {
+		t.Errorf("Expected the objective row to be labeled z, but got %q", lines[2])
+	}
+
+	if !strings.HasPrefix(lines[5], tableau.Variables[3].Name) || !strings.HasSuffix(lines[5], "300") {
+		t.Errorf("Expected the second constraint row to be labeled with its basic variable, but got %q", lines[5])
+	}
+}
+
+/*
+TestTableau_FormatWith1
+Description:
+
+	Verifies that the compact mode omits the columns that are zero in every row.
+*/
+func TestTableau_FormatWith1(t *testing.T) {
+	// Setup
+	tableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Zero out the column of the last variable
+	for ii := 0; ii <= tableau.NumberOfConstraints(); ii++ {
+		tableau.AsCompressedMatrix.Set(ii, 5, 0.0)
+	}
+
+	// Test
+	compact := tableau.FormatWith(utils.TableauFormatOptions{Compact: true})
+
+	// Verify
+	header := strings.Split(compact, "\n")[0]
+	if strings.Contains(header, tableau.Variables[5].Name) {
+		t.Errorf("Expected the zero column to be omitted, but got:\n%v", compact)
+	}
+
+	if !strings.Contains(header, tableau.Variables[4].Name) {
+		t.Errorf("Expected the non-zero columns to be kept, but got:\n%v", compact)
+	}
+
+	if !strings.Contains(compact, "(1 zero columns omitted)") {
+		t.Errorf("Expected a note about the omitted column, but got:\n%v", compact)
+	}
+}
+
+/*
+TestTableau_Format1
+Description:
+
+	Verifies that the '+' flag prints a Markdown table.
+*/
+func TestTableau_Format1(t *testing.T) {
+	// Setup
+	tableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	markdown := fmt.Sprintf("%+v", tableau)
+
+	// Verify
+	lines := strings.Split(strings.TrimSuffix(markdown, "\n"), "\n")
+	if len(lines) != 2+1+tableau.NumberOfConstraints() {
+		t.Fatalf("Expected %v lines, but got:\n%v", 3+tableau.NumberOfConstraints(), markdown)
+	}
+
+	if lines[1] != "| :--- | ---: | ---: | ---: | ---: | ---: | ---: | ---: |" {
+		t.Errorf("Expected the alignment row of a Markdown table, but got %q", lines[1])
+	}
+
+	if lines[2] != "| z | -15 | -25 | 0 | 0 | 0 | 0 | 0 |" {
+		t.Errorf("Expected the objective row, but got %q", lines[2])
+	}
+}
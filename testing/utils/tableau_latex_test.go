@@ -0,0 +1,96 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/simplex/utils"
+	"github.com/MatProGo-dev/simplex/utils/examples"
+)
+
+/*
+TestLaTeXVariableName1
+Description:
+
+	Verifies that subscripted names are written in math mode and that
+	suffixes and special characters are escaped.
+*/
+func TestLaTeXVariableName1(t *testing.T) {
+	testCases := map[string]string{
+		"x_12":         "$x_{12}$",
+		"x_2 (slack)":  "$x_{2}$ (slack)",
+		"cost_&_price": `cost\_\&\_price`,
+	}
+
+	for name, expected := range testCases {
+		if result := utils.LaTeXVariableName(name); result != expected {
+			t.Errorf("Expected LaTeXVariableName(%q) to be %q, but got %q", name, expected, result)
+		}
+	}
+}
+
+/*
+TestTableau_ToLaTeXWithPivot1
+Description:
+
+	Verifies that the tableau from GetTableauExample1 is rendered as a tabular
+	with one header line, one line for the objective row, one line per constraint
+	and a boxed pivot element in the column of variable 1 and the row of variable 3.
+*/
+func TestTableau_ToLaTeXWithPivot1(t *testing.T) {
+	// Setup
+	tableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	latex := tableau.ToLaTeXWithPivot(1, 3)
+
+	// Verify
+	if !strings.HasPrefix(latex, "\\begin{tabular}{c|cccccc|c}\n") {
+		t.Errorf("Expected the output to start with the tabular environment, but got:\n%v", latex)
+	}
+
+	if !strings.HasSuffix(latex, "\\end{tabular}\n") {
+		t.Errorf("Expected the output to end the tabular environment, but got:\n%v", latex)
+	}
+
+	if strings.Count(latex, "\\\\\n") != 1+1+tableau.NumberOfConstraints() {
+		t.Errorf("Expected %v lines in the tabular, but got:\n%v", 2+tableau.NumberOfConstraints(), latex)
+	}
+
+	if strings.Count(latex, "\\boxed{") != 1 {
+		t.Errorf("Expected exactly one boxed element, but got:\n%v", latex)
+	}
+
+	expectedRow := utils.LaTeXVariableName(tableau.Variables[3].Name) +
+		" & $0$ & $\\boxed{1}$ & $0$ & $1$ & $0$ & $0$ & $300$ \\\\\n"
+	if !strings.Contains(latex, expectedRow) {
+		t.Errorf("Expected the output to contain the row %q, but got:\n%v", expectedRow, latex)
+	}
+}
+
+/*
+TestTableau_ToLaTeXWithPivot2
+Description:
+
+	Verifies that an invalid tableau (whose basis refers to a variable that does
+	not exist) is rendered as the error from Check() instead of panicking.
+*/
+func TestTableau_ToLaTeXWithPivot2(t *testing.T) {
+	// Setup
+	tableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	tableau.BasicVariableIndicies = []int{0, 1, 100}
+
+	// Test
+	latex := tableau.ToLaTeXWithPivot(1, 3)
+
+	// Verify
+	if !strings.HasPrefix(latex, "invalid tableau: ") || strings.Contains(latex, "\\begin{tabular}") {
+		t.Errorf("Expected the error to be rendered instead of the tableau, but got:\n%v", latex)
+	}
+}
@@ -2,9 +2,11 @@ package utils_test
 
 import (
 	"fmt"
+	"math/rand"
 	"strings"
 	"testing"
 
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
 	"github.com/MatProGo-dev/simplex/algorithms/tableau/selection"
 	"github.com/MatProGo-dev/simplex/utils"
@@ -220,3 +222,309 @@ func TestTableau_Pivot1(t *testing.T) {
 	}
 
 }
+
+/*
+TestTableau_PivotInPlace1
+Description:
+
+	Verifies that PivotInPlace makes the same pivot as Pivot on example tableau 1,
+	that Pivot leaves the original tableau unchanged and that PivotInPlace rejects
+	an entering variable that is already basic.
+*/
+func TestTableau_PivotInPlace1(t *testing.T) {
+	// Setup
+	testTableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	original := mat.DenseCopyOf(testTableau.AsCompressedMatrix)
+
+	pivoted, err := testTableau.Pivot(1, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !mat.Equal(testTableau.AsCompressedMatrix, original) {
+		t.Errorf("Expected Pivot to leave the original tableau unchanged")
+	}
+
+	// Test
+	err = testTableau.PivotInPlace(1, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if !mat.Equal(testTableau.AsCompressedMatrix, pivoted.AsCompressedMatrix) {
+		t.Errorf(
+			"Expected the tableau to be:\n%v\nbut got:\n%v",
+			mat.Formatted(pivoted.AsCompressedMatrix),
+			mat.Formatted(testTableau.AsCompressedMatrix),
+		)
+	}
+	for ii, bvIdx := range pivoted.BasicVariableIndicies {
+		if testTableau.BasicVariableIndicies[ii] != bvIdx {
+			t.Errorf("Expected the basic variables %v, but got %v", pivoted.BasicVariableIndicies, testTableau.BasicVariableIndicies)
+			break
+		}
+	}
+
+	if err = testTableau.PivotInPlace(1, 4); err == nil {
+		t.Errorf("Expected an error for an entering variable that is already basic, but got none")
+	}
+}
+
+/*
+TestTableau_CanNotBeImprovedWithin1
+Description:
+
+	Verifies that the initial tableau of test problem 5 (whose objective row
+	contains -15 and -25) can be improved unless the tolerance exceeds 25.
+*/
+func TestTableau_CanNotBeImprovedWithin1(t *testing.T) {
+	// Setup
+	tableau, _, err := utils.GetInitialTableauFrom(examples.GetTestProblem5())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if tableau.CanNotBeImproved() || tableau.CanNotBeImprovedWithin(20.0) {
+		t.Errorf("Expected the initial tableau to be improvable")
+	}
+
+	if !tableau.CanNotBeImprovedWithin(30.0) {
+		t.Errorf("Expected the initial tableau to be optimal within a tolerance of 30")
+	}
+}
+
+/*
+TestRecomputeTableau1
+Description:
+
+	Verifies that recomputing the tableau of example 1 for the basis that is reached by two
+	pivots (x_1 replacing the slack of row 2 and x_0 replacing the slack of row 1) gives the
+	same tableau as the pivots.
+*/
+func TestRecomputeTableau1(t *testing.T) {
+	// Setup
+	initialTableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	pivoted, err := initialTableau.Pivot(1, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	pivoted, err = pivoted.Pivot(0, 2)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	recomputed, err := utils.RecomputeTableau(*initialTableau, pivoted.BasicVariableIndicies)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Verify
+	if fmt.Sprint(recomputed.BasicVariableIndicies) != fmt.Sprint(pivoted.BasicVariableIndicies) {
+		t.Errorf("Expected the basic variables %v, but got %v", pivoted.BasicVariableIndicies, recomputed.BasicVariableIndicies)
+	}
+	if !mat.EqualApprox(recomputed.AsCompressedMatrix, pivoted.AsCompressedMatrix, 1e-10) {
+		t.Errorf(
+			"Expected tableau to be:\n%v\nbut got:\n%v",
+			mat.Formatted(pivoted.AsCompressedMatrix),
+			mat.Formatted(recomputed.AsCompressedMatrix),
+		)
+	}
+}
+
+/*
+TestRecomputeTableau2
+Description:
+
+	Verifies that RecomputeTableau returns an error for a basis with the wrong number of
+	variables, for a variable that does not exist and for a singular basis matrix.
+*/
+func TestRecomputeTableau2(t *testing.T) {
+	// Setup
+	initialTableau, err := examples.GetTableauExample1()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	// Test
+	for _, basis := range [][]int{
+		{2, 3, 4},
+		{2, 3, 4, 6},
+		{2, 2, 4, 5},
+	} {
+		_, err = utils.RecomputeTableau(*initialTableau, basis)
+		if err == nil {
+			t.Errorf("Expected an error for the basis %v, but got none", basis)
+		}
+	}
+}
+
+/*
+randomSlackTableau
+Description:
+
+	Creates the tableau of the slack basis of a random problem max c^T x s.t. A x <= b,
+	x >= 0 with nConstraints constraints, nVariables variables and positive entries in
+	A, b and c (so that the slack basis is feasible and the problem is bounded).
+*/
+func randomSlackTableau(nConstraints, nVariables int, seed int64) utils.Tableau {
+	// Setup
+	rng := rand.New(rand.NewSource(seed))
+	nCols := nVariables + nConstraints + 1
+	T := mat.NewDense(nConstraints+1, nCols, nil)
+
+	// Fill the objective row and the constraint rows
+	for jj := 0; jj < nVariables; jj++ {
+		T.Set(0, jj, -(1.0 + rng.Float64()))
+	}
+	for ii := 1; ii <= nConstraints; ii++ {
+		for jj := 0; jj < nVariables; jj++ {
+			T.Set(ii, jj, 0.1+rng.Float64())
+		}
+		T.Set(ii, nVariables+ii-1, 1.0)
+		T.Set(ii, nCols-1, float64(nVariables)*(1.0+rng.Float64()))
+	}
+
+	out := utils.Tableau{AsCompressedMatrix: T}
+	for jj := 0; jj < nCols-1; jj++ {
+		out.Variables = append(out.Variables, symbolic.NewVariable())
+	}
+	for ii := 0; ii < nConstraints; ii++ {
+		out.BasicVariableIndicies = append(out.BasicVariableIndicies, nVariables+ii)
+	}
+	return out
+}
+
+/*
+benchmarkTableauPivots
+Description:
+
+	Replays the pivots that Bland's Rule makes on a random 40 x 60 tableau until it is
+	optimal, either creating a new tableau for every pivot (Pivot) or pivoting a copy of
+	the tableau in place (PivotInPlace). The pivots are selected before the timer starts
+	so that only the pivoting is measured.
+*/
+func benchmarkTableauPivots(b *testing.B, inPlace bool) {
+	// Setup
+	initial := randomSlackTableau(40, 60, 1)
+	copyOf := func(tableau utils.Tableau) utils.Tableau {
+		return utils.Tableau{
+			Variables:             tableau.Variables,
+			BasicVariableIndicies: append([]int{}, tableau.BasicVariableIndicies...),
+			AsCompressedMatrix:    mat.DenseCopyOf(tableau.AsCompressedMatrix),
+		}
+	}
+
+	// Select the pivots
+	type pivot struct{ entering, exiting int }
+	var pivots []pivot
+	tableau := copyOf(initial)
+	for len(pivots) < 1000 {
+		enteringVarIdx, exitingVarIdx, err := selection.BlandsRule{}.SelectEnteringAndExitingVariables(tableau)
+		if err != nil {
+			b.Fatalf("Expected no error, but got: %v", err)
+		}
+		if enteringVarIdx == -1 {
+			break
+		}
+		err = tableau.PivotInPlace(enteringVarIdx, exitingVarIdx)
+		if err != nil {
+			b.Fatalf("Expected no error, but got: %v", err)
+		}
+		pivots = append(pivots, pivot{enteringVarIdx, exitingVarIdx})
+	}
+	if len(pivots) == 0 {
+		b.Fatalf("Expected the initial tableau to need pivots, but it is optimal")
+	}
+
+	// Replay the pivots
+	workspace := copyOf(initial)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for ii := 0; ii < b.N; ii++ {
+		var err error
+		if inPlace {
+			workspace.AsCompressedMatrix.Copy(initial.AsCompressedMatrix)
+			copy(workspace.BasicVariableIndicies, initial.BasicVariableIndicies)
+			tableau = workspace
+		} else {
+			tableau = initial
+		}
+
+		for _, p := range pivots {
+			if inPlace {
+				err = tableau.PivotInPlace(p.entering, p.exiting)
+			} else {
+				tableau, err = tableau.Pivot(p.entering, p.exiting)
+			}
+			if err != nil {
+				b.Fatalf("Expected no error, but got: %v", err)
+			}
+		}
+	}
+	b.ReportMetric(float64(len(pivots)), "pivots/op")
+}
+
+func BenchmarkTableau_Pivot(b *testing.B) {
+	benchmarkTableauPivots(b, false)
+}
+
+func BenchmarkTableau_PivotInPlace(b *testing.B) {
+	benchmarkTableauPivots(b, true)
+}
+
+/*
+TestTableau_CheckSlackBasis1
+Description:
+
+	Verifies that the slack basis of a problem with only <= constraints is accepted and
+	that the slack bases of problems with an equality or a >= constraint (which do not
+	fit the rows of the tableau) are rejected.
+*/
+func TestTableau_CheckSlackBasis1(t *testing.T) {
+	// Setup
+	testCases := []struct {
+		Name          string
+		Constraint    func(x symbolic.Variable) symbolic.Constraint
+		ExpectedError string
+	}{
+		{"<= constraint", func(x symbolic.Variable) symbolic.Constraint { return x.LessEq(3.0) }, ""},
+		{"equality constraint", func(x symbolic.Variable) symbolic.Constraint { return x.Eq(3.0) }, "equality constraints are not supported"},
+		{">= constraint", func(x symbolic.Variable) symbolic.Constraint { return x.GreaterEq(1.0) }, ">= constraints are not supported"},
+	}
+
+	for _, tc := range testCases {
+		prob := problem.NewProblem(tc.Name)
+		x := prob.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+		y := prob.AddVariableClassic(0, symbolic.Infinity.Constant(), symbolic.Continuous)
+		prob.SetObjective(x.Plus(y.Multiply(2.0)), problem.SenseMaximize)
+		prob.Constraints = append(prob.Constraints, x.Plus(y).LessEq(4.0), tc.Constraint(x))
+
+		tableau, _, err := utils.GetInitialTableauFrom(prob)
+		if err != nil {
+			t.Fatalf("%v: Expected no error, but got: %v", tc.Name, err)
+		}
+
+		// Test
+		err = tableau.CheckSlackBasis()
+
+		// Verify
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Errorf("%v: Expected no error, but got: %v", tc.Name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+			t.Errorf("%v: Expected an error containing \"%v\", but got: %v", tc.Name, tc.ExpectedError, err)
+		}
+	}
+}
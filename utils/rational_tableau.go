@@ -0,0 +1,431 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+// The Rational Tableau is the exact counterpart of the Tableau.
+// It represents the same problem
+//
+//	minimize 		c^T * x + d
+//	subject to 		A * x = b
+//					x >= 0
+//
+// but every entry of the compressed matrix
+//
+//	| c^T | d |
+//	|  A  | b |
+//
+// is a fraction (big.Rat), so that pivoting introduces no rounding errors.
+type RationalTableau struct {
+	Variables             []symbolic.Variable
+	BasicVariableIndicies []int        // The basic variables in order of their connection to the constraint rows
+	AsCompressedMatrix    [][]*big.Rat // The rows of the compressed matrix (the objective row first)
+}
+
+/*
+RatFromFloat64
+Description:
+
+	Converts a float64 into the fraction given by its shortest decimal representation,
+	so that, e.g., 0.1 becomes 1/10 (and not the binary fraction closest to 0.1).
+	Panics if the value is not finite.
+*/
+func RatFromFloat64(value float64) *big.Rat {
+	// Input Processing
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		panic(fmt.Errorf("RatFromFloat64: the value %v is not finite", value))
+	}
+
+	out, ok := new(big.Rat).SetString(strconv.FormatFloat(value, 'g', -1, 64))
+	if !ok {
+		panic(fmt.Errorf("RatFromFloat64: could not convert %v into a fraction", value))
+	}
+	return out
+}
+
+/*
+NewRationalTableau
+Description:
+
+	Converts a tableau into a rational tableau. Each entry is converted with RatFromFloat64.
+*/
+func NewRationalTableau(tableau Tableau) (RationalTableau, error) {
+	// Input Processing
+	err := tableau.Check()
+	if err != nil {
+		return RationalTableau{}, fmt.Errorf("NewRationalTableau: %v", err)
+	}
+
+	// Setup
+	nTableauRows, nTableauCols := tableau.AsCompressedMatrix.Dims()
+
+	// Convert each entry
+	matrix := make([][]*big.Rat, nTableauRows)
+	for ii := 0; ii < nTableauRows; ii++ {
+		matrix[ii] = make([]*big.Rat, nTableauCols)
+		for jj := 0; jj < nTableauCols; jj++ {
+			value := tableau.AsCompressedMatrix.At(ii, jj)
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				return RationalTableau{}, fmt.Errorf(
+					"NewRationalTableau: the entry (%v,%v) of the tableau is not finite (%v)",
+					ii, jj, value,
+				)
+			}
+			matrix[ii][jj] = RatFromFloat64(value)
+		}
+	}
+
+	basicVariableIndicies := make([]int, len(tableau.BasicVariableIndicies))
+	copy(basicVariableIndicies, tableau.BasicVariableIndicies)
+
+	return RationalTableau{
+		Variables:             tableau.Variables,
+		BasicVariableIndicies: basicVariableIndicies,
+		AsCompressedMatrix:    matrix,
+	}, nil
+}
+
+/*
+Check
+Description:
+
+	This method checks whether or not the RationalTableau is well-defined.
+	Specifically, we check:
+	- BasicVariableIndicies are within the range [0, len(tableau.Variables)]
+	- There is one basic variable per constraint row
+	- Every row has len(AllVariables) + 1 entries and no entry is nil
+*/
+func (tableau *RationalTableau) Check() error {
+	// Check the BasicVariableIndicies
+	nVariables := len(tableau.Variables)
+	for _, bvIndex := range tableau.BasicVariableIndicies {
+		if (bvIndex < 0) || (bvIndex >= nVariables) {
+			return fmt.Errorf(
+				"the basic variable %v is outside of the expected range [0,%v]",
+				bvIndex,
+				nVariables-1,
+			)
+		}
+	}
+
+	// Check the number of rows
+	if len(tableau.AsCompressedMatrix) != len(tableau.BasicVariableIndicies)+1 {
+		return fmt.Errorf(
+			"The number of rows in the tableau is %v; expected %v rows.",
+			len(tableau.AsCompressedMatrix),
+			len(tableau.BasicVariableIndicies)+1,
+		)
+	}
+
+	// Check the rows
+	for ii, row := range tableau.AsCompressedMatrix {
+		if len(row) != nVariables+1 {
+			return fmt.Errorf(
+				"The number of columns in row %v of the tableau is %v; expected %v columns.",
+				ii,
+				len(row),
+				nVariables+1,
+			)
+		}
+		for jj, entry := range row {
+			if entry == nil {
+				return fmt.Errorf("the entry (%v,%v) of the tableau is nil", ii, jj)
+			}
+		}
+	}
+
+	// All Checks passed
+	return nil
+}
+
+/*
+At
+Description:
+
+	Returns a copy of the entry (ii, jj) of the compressed matrix.
+*/
+func (tableau *RationalTableau) At(ii, jj int) *big.Rat {
+	return new(big.Rat).Set(tableau.AsCompressedMatrix[ii][jj])
+}
+
+/*
+C
+Description:
+
+	Extracts the C vector (linear objective vector) from the tableau.
+*/
+func (tableau *RationalTableau) C() []*big.Rat {
+	// Check that tableau is valid
+	err := tableau.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Copy the top row (without the constant term)
+	out := make([]*big.Rat, len(tableau.Variables))
+	for jj := range out {
+		out[jj] = tableau.At(0, jj)
+	}
+	return out
+}
+
+/*
+B
+Description:
+
+	Extracts the B vector (right hand side of the constraint rows) from the tableau.
+*/
+func (tableau *RationalTableau) B() []*big.Rat {
+	// Check that tableau is valid
+	err := tableau.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Copy the last column (without the objective row)
+	out := make([]*big.Rat, tableau.NumberOfConstraints())
+	for ii := range out {
+		out[ii] = tableau.At(ii+1, len(tableau.Variables))
+	}
+	return out
+}
+
+/*
+D
+Description:
+
+	Returns the constant term of the objective row.
+*/
+func (tableau *RationalTableau) D() *big.Rat {
+	// Check that tableau is valid
+	err := tableau.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	return tableau.At(0, len(tableau.Variables))
+}
+
+func (tableau *RationalTableau) NumberOfConstraints() int {
+	return len(tableau.AsCompressedMatrix) - 1
+}
+
+func (tableau *RationalTableau) NonBasicVariableIndicies() []int {
+	// Input Processing
+	err := tableau.Check()
+	if err != nil {
+		panic(
+			fmt.Errorf("tableau provided to NonBasicVariablesIndicies() was invalid: %v", err),
+		)
+	}
+
+	// Algorithm
+	out := []int{}
+	for ii := 0; ii < len(tableau.Variables); ii++ {
+		if foundIdx, _ := symbolic.FindInSlice(ii, tableau.BasicVariableIndicies); foundIdx == -1 {
+			out = append(out, ii)
+		}
+	}
+
+	return out
+}
+
+func (tableau *RationalTableau) BasicVariables() []symbolic.Variable {
+	out := []symbolic.Variable{}
+	for _, bvIndex := range tableau.BasicVariableIndicies {
+		out = append(out, tableau.Variables[bvIndex])
+	}
+	return out
+}
+
+/*
+CanNotBeImproved
+Description:
+
+	Returns true if no entry of the objective row is negative.
+*/
+func (tableau *RationalTableau) CanNotBeImproved() bool {
+	for _, cj := range tableau.C() {
+		if cj.Sign() < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+BasicSolution
+Description:
+
+	Returns the value of every variable of the tableau (in the order of Variables) in the
+	basic solution of the current basis: the basic variables take the value of the right
+	hand side of their row and the non-basic variables are zero.
+*/
+func (tableau *RationalTableau) BasicSolution() []*big.Rat {
+	// Setup
+	out := make([]*big.Rat, len(tableau.Variables))
+	for jj := range out {
+		out[jj] = new(big.Rat)
+	}
+
+	// Read the values of the basic variables
+	b := tableau.B()
+	for ii, bvIdx := range tableau.BasicVariableIndicies {
+		out[bvIdx] = b[ii]
+	}
+
+	return out
+}
+
+/*
+Pivot
+Description:
+
+	Performs the same pivot as Tableau.Pivot (the entering variable replaces the exiting
+	variable in the basis) with exact arithmetic and returns the resulting tableau.
+*/
+func (tableau *RationalTableau) Pivot(enteringVarIdx int, exitingVarIdx int) (RationalTableau, error) {
+	// Input Processing
+	err := tableau.Check()
+	if err != nil {
+		return RationalTableau{}, fmt.Errorf("Pivot: %v", err)
+	}
+
+	// Check that the entering variable is not already a basic variable
+	if foundIdx, _ := symbolic.FindInSlice(enteringVarIdx, tableau.BasicVariableIndicies); foundIdx != -1 {
+		return RationalTableau{}, fmt.Errorf("Pivot: the entering variable is already a basic variable")
+	}
+
+	if enteringVarIdx < 0 || enteringVarIdx >= len(tableau.Variables) {
+		return RationalTableau{}, fmt.Errorf("Pivot: the entering variable %v is not a variable of the tableau", enteringVarIdx)
+	}
+
+	// Check that the exiting variable is a basic variable
+	exitingConstraintIdx, _ := symbolic.FindInSlice(exitingVarIdx, tableau.BasicVariableIndicies)
+	if exitingConstraintIdx == -1 {
+		return RationalTableau{}, fmt.Errorf("Pivot: the exiting variable is not a basic variable")
+	}
+
+	pivotElement := tableau.AsCompressedMatrix[exitingConstraintIdx+1][enteringVarIdx]
+	if pivotElement.Sign() == 0 {
+		return RationalTableau{}, fmt.Errorf("Pivot: the pivot element is zero")
+	}
+
+	// Perform the pivot operation
+	// - "Normalize" the pivot row
+	nCols := len(tableau.Variables) + 1
+	normalizingFactor := new(big.Rat).Inv(pivotElement)
+	newPivotRow := make([]*big.Rat, nCols)
+	for jj := 0; jj < nCols; jj++ {
+		newPivotRow[jj] = new(big.Rat).Mul(tableau.AsCompressedMatrix[exitingConstraintIdx+1][jj], normalizingFactor)
+	}
+
+	// - Zero out the other entries in the entering variable column
+	newMatrix := make([][]*big.Rat, len(tableau.AsCompressedMatrix))
+	for ii, row := range tableau.AsCompressedMatrix {
+		if ii == exitingConstraintIdx+1 {
+			newMatrix[ii] = newPivotRow
+			continue
+		}
+
+		factor := row[enteringVarIdx]
+		newMatrix[ii] = make([]*big.Rat, nCols)
+		for jj := 0; jj < nCols; jj++ {
+			newMatrix[ii][jj] = new(big.Rat).Set(row[jj])
+			if factor.Sign() != 0 {
+				newMatrix[ii][jj].Sub(newMatrix[ii][jj], new(big.Rat).Mul(factor, newPivotRow[jj]))
+			}
+		}
+	}
+
+	// Update the list of basic variable indicies
+	newBasicVariableIndicies := make([]int, len(tableau.BasicVariableIndicies))
+	copy(newBasicVariableIndicies, tableau.BasicVariableIndicies)
+	newBasicVariableIndicies[exitingConstraintIdx] = enteringVarIdx
+
+	return RationalTableau{
+		Variables:             tableau.Variables,
+		BasicVariableIndicies: newBasicVariableIndicies,
+		AsCompressedMatrix:    newMatrix,
+	}, nil
+}
+
+/*
+ToTableau
+Description:
+
+	Converts the rational tableau into a (float64) tableau. Each entry is rounded
+	to the nearest float64.
+*/
+func (tableau *RationalTableau) ToTableau() (Tableau, error) {
+	// Input Processing
+	err := tableau.Check()
+	if err != nil {
+		return Tableau{}, fmt.Errorf("ToTableau: %v", err)
+	}
+
+	// Convert each entry
+	nRows, nCols := len(tableau.AsCompressedMatrix), len(tableau.Variables)+1
+	matrix := mat.NewDense(nRows, nCols, nil)
+	for ii, row := range tableau.AsCompressedMatrix {
+		for jj, entry := range row {
+			value, _ := entry.Float64()
+			matrix.Set(ii, jj, value)
+		}
+	}
+
+	basicVariableIndicies := make([]int, len(tableau.BasicVariableIndicies))
+	copy(basicVariableIndicies, tableau.BasicVariableIndicies)
+
+	return Tableau{
+		Variables:             tableau.Variables,
+		BasicVariableIndicies: basicVariableIndicies,
+		AsCompressedMatrix:    matrix,
+	}, nil
+}
+
+/*
+String
+Description:
+
+	Returns the tableau as aligned plain text (in the same layout as Tableau.String)
+	with every entry written as a fraction (e.g., 25/4).
+*/
+func (tableau *RationalTableau) String() string {
+	// Check that tableau is valid
+	err := tableau.Check()
+	if err != nil {
+		return fmt.Sprintf("invalid tableau: %v", err)
+	}
+
+	// Assemble the cells of the table
+	header := []string{"Basis"}
+	for _, v := range tableau.Variables {
+		header = append(header, v.Name)
+	}
+	header = append(header, "RHS")
+
+	rows := [][]string{}
+	basicVariables := tableau.BasicVariables()
+	for ii, entries := range tableau.AsCompressedMatrix {
+		label := "z"
+		if ii > 0 {
+			label = basicVariables[ii-1].Name
+		}
+
+		row := []string{label}
+		for _, entry := range entries {
+			row = append(row, entry.RatString())
+		}
+		rows = append(rows, row)
+	}
+
+	return formatPlainTable(header, rows)
+}
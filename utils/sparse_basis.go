@@ -0,0 +1,345 @@
+package utils
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sort"
+)
+
+/*
+SparseBasis
+Description:
+
+	A basis of a sparse constraint matrix A, stored in product form: the inverse of the
+	basis matrix B (whose i-th column is the column of A of BasicVariableIndicies[i]) is
+	the product E_k ... E_1 of elementary "eta" matrices, each of which differs from the
+	identity in one column. Only the nonzero entries of the eta columns are stored, so
+	that FTRAN (B^{-1} x) and BTRAN (B^{-T} y) cost about as much as the nonzeros of
+	the etas. Every Replace adds one eta; Refactorize rebuilds the product from A.
+*/
+type SparseBasis struct {
+	BasicVariableIndicies []int
+
+	a         *CSCMatrix
+	etas      []eta
+	tolerance float64
+}
+
+/*
+eta
+Description:
+
+	The elementary matrix that pivots on the entry (row, row) of a column alpha:
+	its column row is (-alpha_i / alpha_row for i != row, 1 / alpha_row on the
+	diagonal). Only the nonzero off-diagonal entries of alpha are stored.
+*/
+type eta struct {
+	row    int
+	pivot  float64
+	rows   []int
+	values []float64
+}
+
+/*
+NewSparseBasis
+Description:
+
+	Factorizes the basis of A whose columns are the given basic variables
+	(see Refactorize). Pivot elements with a magnitude below tolerance are
+	treated as zero.
+*/
+func NewSparseBasis(A *CSCMatrix, basicVariableIndicies []int, tolerance float64) (*SparseBasis, error) {
+	// Input Processing
+	if len(basicVariableIndicies) != A.NRows {
+		return nil, fmt.Errorf(
+			"NewSparseBasis: expected %v basic variables, but received %v",
+			A.NRows,
+			len(basicVariableIndicies),
+		)
+	}
+	for _, bvIdx := range basicVariableIndicies {
+		if bvIdx < 0 || bvIdx >= A.NCols {
+			return nil, fmt.Errorf("NewSparseBasis: the basic variable %v does not exist", bvIdx)
+		}
+	}
+
+	// Factorize
+	basis := &SparseBasis{
+		BasicVariableIndicies: append([]int{}, basicVariableIndicies...),
+		a:                     A,
+		tolerance:             tolerance,
+	}
+	err := basis.Refactorize()
+	if err != nil {
+		return nil, err
+	}
+
+	return basis, nil
+}
+
+/*
+Refactorize
+Description:
+
+	Rebuilds the product form of the inverse from the columns of A, starting from the
+	identity and pivoting the basic columns in one at a time (the sparsest columns first,
+	each on the free row with the largest magnitude). This removes the etas of the
+	previous Replace calls and the rounding errors that they accumulated.
+	The basic variables may be assigned to different rows afterwards.
+	Returns an error (and leaves the basis unchanged) if the basis matrix is singular.
+*/
+func (basis *SparseBasis) Refactorize() error {
+	// Setup
+	nRows := basis.a.NRows
+	columns := append([]int{}, basis.BasicVariableIndicies...)
+	sort.SliceStable(columns, func(ii, jj int) bool {
+		return basis.a.ColumnStarts[columns[ii]+1]-basis.a.ColumnStarts[columns[ii]] <
+			basis.a.ColumnStarts[columns[jj]+1]-basis.a.ColumnStarts[columns[jj]]
+	})
+
+	factorized := &SparseBasis{
+		BasicVariableIndicies: make([]int, nRows),
+		a:                     basis.a,
+		tolerance:             basis.tolerance,
+	}
+	isAssigned := make([]bool, nRows)
+
+	// Pivot the columns in (visiting only the nonzero entries of each transformed column,
+	// so that a refactorization costs about as much as the nonzeros of the etas)
+	work := make([]float64, nRows)
+	isInPattern := make([]bool, nRows)
+	etaOfRow := make([]int, nRows)
+	for ii := range etaOfRow {
+		etaOfRow[ii] = -1
+	}
+	var pattern []int
+	for _, colIdx := range columns {
+		pattern = factorized.sparseColumnOf(colIdx, work, isInPattern, etaOfRow, pattern[:0])
+
+		pivotRow := -1
+		for _, ii := range pattern {
+			if isAssigned[ii] || math.Abs(work[ii]) <= basis.tolerance {
+				continue
+			}
+			if pivotRow == -1 || math.Abs(work[ii]) > math.Abs(work[pivotRow]) {
+				pivotRow = ii
+			}
+		}
+		if pivotRow == -1 {
+			return fmt.Errorf("Refactorize: the basis matrix is singular (column %v is dependent on the others)", colIdx)
+		}
+
+		if factorized.addSparseEta(pivotRow, work, pattern) {
+			etaOfRow[pivotRow] = len(factorized.etas) - 1
+		}
+		factorized.BasicVariableIndicies[pivotRow] = colIdx
+		isAssigned[pivotRow] = true
+
+		// Clear the work vector
+		for _, ii := range pattern {
+			work[ii] = 0.0
+			isInPattern[ii] = false
+		}
+	}
+
+	*basis = *factorized
+	return nil
+}
+
+/*
+ColumnOf
+Description:
+
+	Computes B^{-1} A_j (the column of variable j in the current tableau) into out,
+	which must have one entry per row.
+*/
+func (basis *SparseBasis) ColumnOf(j int, out []float64) {
+	for ii := range out {
+		out[ii] = 0.0
+	}
+	rows, values := basis.a.Column(j)
+	for k, ii := range rows {
+		out[ii] = values[k]
+	}
+	basis.FTRAN(out)
+}
+
+/*
+sparseColumnOf
+Description:
+
+	Computes B^{-1} A_j into work (which must be zero) like ColumnOf and returns the rows
+	of its (possibly) nonzero entries, appended to pattern. isInPattern marks these rows.
+	etaOfRow[i] is the index of the eta that pivots on row i (or -1), which must be unique
+	(as it is during Refactorize): then only the etas of the pattern's rows are visited.
+*/
+func (basis *SparseBasis) sparseColumnOf(j int, work []float64, isInPattern []bool, etaOfRow []int, pattern []int) []int {
+	// Setup
+	pending := &intHeap{}
+	addToPattern := func(ii int, current int) []int {
+		isInPattern[ii] = true
+		if etaOfRow[ii] > current {
+			heap.Push(pending, etaOfRow[ii])
+		}
+		return append(pattern, ii)
+	}
+
+	// Scatter the column
+	rows, values := basis.a.Column(j)
+	for k, ii := range rows {
+		work[ii] = values[k]
+		pattern = addToPattern(ii, -1)
+	}
+
+	// Apply the etas of the pattern's rows in order (like FTRAN), recording the entries that become nonzero
+	for pending.Len() > 0 {
+		etaIdx := heap.Pop(pending).(int)
+		e := basis.etas[etaIdx]
+		if work[e.row] == 0 {
+			continue
+		}
+		work[e.row] /= e.pivot
+		for k, ii := range e.rows {
+			work[ii] -= e.values[k] * work[e.row]
+			if !isInPattern[ii] {
+				pattern = addToPattern(ii, etaIdx)
+			}
+		}
+	}
+
+	return pattern
+}
+
+// intHeap is a min-heap of ints (see container/heap).
+type intHeap []int
+
+func (h intHeap) Len() int           { return len(h) }
+func (h intHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *intHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *intHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+/*
+FTRAN
+Description:
+
+	Replaces x by B^{-1} x.
+*/
+func (basis *SparseBasis) FTRAN(x []float64) {
+	for _, e := range basis.etas {
+		if x[e.row] == 0 {
+			continue
+		}
+		x[e.row] /= e.pivot
+		for k, ii := range e.rows {
+			x[ii] -= e.values[k] * x[e.row]
+		}
+	}
+}
+
+/*
+BTRAN
+Description:
+
+	Replaces y by B^{-T} y (i.e., solves y'^T B = y^T).
+*/
+func (basis *SparseBasis) BTRAN(y []float64) {
+	for ee := len(basis.etas) - 1; ee >= 0; ee-- {
+		e := basis.etas[ee]
+		value := y[e.row]
+		for k, ii := range e.rows {
+			value -= e.values[k] * y[ii]
+		}
+		y[e.row] = value / e.pivot
+	}
+}
+
+/*
+Replace
+Description:
+
+	Makes enteringVarIdx the basic variable of the given row, where alpha is the
+	column of the entering variable in the current tableau (see ColumnOf).
+	Returns an error if alpha's entry in the row is (numerically) zero.
+*/
+func (basis *SparseBasis) Replace(row int, enteringVarIdx int, alpha []float64) error {
+	if math.Abs(alpha[row]) <= basis.tolerance {
+		return fmt.Errorf("Replace: the pivot element %v is too small", alpha[row])
+	}
+	basis.addEta(row, alpha)
+	basis.BasicVariableIndicies[row] = enteringVarIdx
+	return nil
+}
+
+/*
+NumberOfEtas
+Description:
+
+	Returns the number of elementary matrices in the product form of the inverse.
+*/
+func (basis *SparseBasis) NumberOfEtas() int {
+	return len(basis.etas)
+}
+
+/*
+NNZ
+Description:
+
+	Returns the number of stored nonzero entries of the product form of the inverse.
+*/
+func (basis *SparseBasis) NNZ() int {
+	out := 0
+	for _, e := range basis.etas {
+		out += len(e.values) + 1
+	}
+	return out
+}
+
+/*
+addEta
+Description:
+
+	Appends the eta of a pivot on the given row of the column alpha.
+	An eta that is the identity is not stored.
+*/
+func (basis *SparseBasis) addEta(row int, alpha []float64) {
+	e := eta{row: row, pivot: alpha[row]}
+	for ii, value := range alpha {
+		if ii != row && value != 0 {
+			e.rows = append(e.rows, ii)
+			e.values = append(e.values, value)
+		}
+	}
+	if e.pivot == 1 && len(e.rows) == 0 {
+		return
+	}
+	basis.etas = append(basis.etas, e)
+}
+
+/*
+addSparseEta
+Description:
+
+	Same as addEta, where alpha is only nonzero in the rows of pattern.
+	Returns true if the eta was stored.
+*/
+func (basis *SparseBasis) addSparseEta(row int, alpha []float64, pattern []int) bool {
+	e := eta{row: row, pivot: alpha[row]}
+	for _, ii := range pattern {
+		if ii != row && alpha[ii] != 0 {
+			e.rows = append(e.rows, ii)
+			e.values = append(e.values, alpha[ii])
+		}
+	}
+	if e.pivot == 1 && len(e.rows) == 0 {
+		return false
+	}
+	basis.etas = append(basis.etas, e)
+	return true
+}
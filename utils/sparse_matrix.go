@@ -0,0 +1,337 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+CSCMatrix
+Description:
+
+	A sparse matrix in compressed sparse column format. The nonzero entries of
+	column j are Values[ColumnStarts[j]:ColumnStarts[j+1]] and they lie in the rows
+	RowIndices[ColumnStarts[j]:ColumnStarts[j+1]] (in increasing order).
+*/
+type CSCMatrix struct {
+	NRows        int
+	NCols        int
+	ColumnStarts []int
+	RowIndices   []int
+	Values       []float64
+}
+
+/*
+CSRMatrix
+Description:
+
+	A sparse matrix in compressed sparse row format. The nonzero entries of row i
+	are Values[RowStarts[i]:RowStarts[i+1]] and they lie in the columns
+	ColumnIndices[RowStarts[i]:RowStarts[i+1]] (in increasing order).
+*/
+type CSRMatrix struct {
+	NRows         int
+	NCols         int
+	RowStarts     []int
+	ColumnIndices []int
+	Values        []float64
+}
+
+/*
+NewCSCMatrixFromTriplets
+Description:
+
+	Creates an nRows x nCols CSCMatrix from the entries (rows[k], cols[k], values[k]).
+	Entries with the same position are added together and zero entries are dropped.
+*/
+func NewCSCMatrixFromTriplets(nRows, nCols int, rows, cols []int, values []float64) (CSCMatrix, error) {
+	// Input Processing
+	if nRows < 0 || nCols < 0 {
+		return CSCMatrix{}, fmt.Errorf("NewCSCMatrixFromTriplets: the dimensions (%v, %v) cannot be negative", nRows, nCols)
+	}
+	if len(rows) != len(values) || len(cols) != len(values) {
+		return CSCMatrix{}, fmt.Errorf(
+			"NewCSCMatrixFromTriplets: received %v row indices, %v column indices and %v values",
+			len(rows),
+			len(cols),
+			len(values),
+		)
+	}
+	for kk := range values {
+		if rows[kk] < 0 || rows[kk] >= nRows || cols[kk] < 0 || cols[kk] >= nCols {
+			return CSCMatrix{}, fmt.Errorf(
+				"NewCSCMatrixFromTriplets: the entry (%v, %v) is outside of the %v x %v matrix",
+				rows[kk],
+				cols[kk],
+				nRows,
+				nCols,
+			)
+		}
+	}
+
+	// Sort the entries by column and then by row
+	order := make([]int, len(values))
+	for kk := range order {
+		order[kk] = kk
+	}
+	sort.SliceStable(order, func(ii, jj int) bool {
+		a, b := order[ii], order[jj]
+		if cols[a] != cols[b] {
+			return cols[a] < cols[b]
+		}
+		return rows[a] < rows[b]
+	})
+
+	// Compress the entries (adding duplicates together)
+	out := CSCMatrix{NRows: nRows, NCols: nCols, ColumnStarts: make([]int, nCols+1)}
+	for pos := 0; pos < len(order); {
+		row, col, value := rows[order[pos]], cols[order[pos]], 0.0
+		for ; pos < len(order) && rows[order[pos]] == row && cols[order[pos]] == col; pos++ {
+			value += values[order[pos]]
+		}
+		if value == 0 {
+			continue
+		}
+		out.RowIndices = append(out.RowIndices, row)
+		out.Values = append(out.Values, value)
+		out.ColumnStarts[col+1]++
+	}
+	for jj := 0; jj < nCols; jj++ {
+		out.ColumnStarts[jj+1] += out.ColumnStarts[jj]
+	}
+
+	return out, nil
+}
+
+/*
+NewCSCMatrixFromDense
+Description:
+
+	Creates a CSCMatrix that contains the nonzero entries of the matrix m.
+*/
+func NewCSCMatrixFromDense(m mat.Matrix) CSCMatrix {
+	// Setup
+	nRows, nCols := m.Dims()
+	out := CSCMatrix{NRows: nRows, NCols: nCols, ColumnStarts: make([]int, nCols+1)}
+
+	// Collect the nonzeros column by column
+	for jj := 0; jj < nCols; jj++ {
+		for ii := 0; ii < nRows; ii++ {
+			if value := m.At(ii, jj); value != 0 {
+				out.RowIndices = append(out.RowIndices, ii)
+				out.Values = append(out.Values, value)
+			}
+		}
+		out.ColumnStarts[jj+1] = len(out.Values)
+	}
+
+	return out
+}
+
+/*
+Dims
+Description:
+
+	Returns the number of rows and columns of the matrix.
+*/
+func (m *CSCMatrix) Dims() (int, int) {
+	return m.NRows, m.NCols
+}
+
+/*
+NNZ
+Description:
+
+	Returns the number of stored (nonzero) entries of the matrix.
+*/
+func (m *CSCMatrix) NNZ() int {
+	return len(m.Values)
+}
+
+/*
+Column
+Description:
+
+	Returns the row indices and the values of the nonzero entries of column j.
+	The slices share their memory with the matrix and must not be modified.
+*/
+func (m *CSCMatrix) Column(j int) ([]int, []float64) {
+	start, end := m.ColumnStarts[j], m.ColumnStarts[j+1]
+	return m.RowIndices[start:end], m.Values[start:end]
+}
+
+/*
+At
+Description:
+
+	Returns the entry (i, j) of the matrix.
+*/
+func (m *CSCMatrix) At(i, j int) float64 {
+	rows, values := m.Column(j)
+	if k := sort.SearchInts(rows, i); k < len(rows) && rows[k] == i {
+		return values[k]
+	}
+	return 0.0
+}
+
+/*
+MulVec
+Description:
+
+	Returns the product m * x.
+*/
+func (m *CSCMatrix) MulVec(x []float64) []float64 {
+	out := make([]float64, m.NRows)
+	for jj := 0; jj < m.NCols; jj++ {
+		if x[jj] == 0 {
+			continue
+		}
+		rows, values := m.Column(jj)
+		for k, ii := range rows {
+			out[ii] += values[k] * x[jj]
+		}
+	}
+	return out
+}
+
+/*
+ColumnDot
+Description:
+
+	Returns the inner product of column j of the matrix with the dense vector y.
+*/
+func (m *CSCMatrix) ColumnDot(j int, y []float64) float64 {
+	out := 0.0
+	rows, values := m.Column(j)
+	for k, ii := range rows {
+		out += values[k] * y[ii]
+	}
+	return out
+}
+
+/*
+ToCSR
+Description:
+
+	Returns the same matrix in compressed sparse row format.
+*/
+func (m *CSCMatrix) ToCSR() CSRMatrix {
+	// Count the entries of each row
+	out := CSRMatrix{
+		NRows:         m.NRows,
+		NCols:         m.NCols,
+		RowStarts:     make([]int, m.NRows+1),
+		ColumnIndices: make([]int, len(m.Values)),
+		Values:        make([]float64, len(m.Values)),
+	}
+	for _, ii := range m.RowIndices {
+		out.RowStarts[ii+1]++
+	}
+	for ii := 0; ii < m.NRows; ii++ {
+		out.RowStarts[ii+1] += out.RowStarts[ii]
+	}
+
+	// Scatter the entries (the columns are visited in order, so each row stays sorted)
+	next := append([]int{}, out.RowStarts[:m.NRows]...)
+	for jj := 0; jj < m.NCols; jj++ {
+		rows, values := m.Column(jj)
+		for k, ii := range rows {
+			out.ColumnIndices[next[ii]] = jj
+			out.Values[next[ii]] = values[k]
+			next[ii]++
+		}
+	}
+
+	return out
+}
+
+/*
+ToDense
+Description:
+
+	Returns the matrix as a mat.Dense (e.g., to print small matrices).
+*/
+func (m *CSCMatrix) ToDense() *mat.Dense {
+	if m.NRows == 0 || m.NCols == 0 {
+		return &mat.Dense{}
+	}
+	out := mat.NewDense(m.NRows, m.NCols, nil)
+	for jj := 0; jj < m.NCols; jj++ {
+		rows, values := m.Column(jj)
+		for k, ii := range rows {
+			out.Set(ii, jj, values[k])
+		}
+	}
+	return out
+}
+
+/*
+Dims
+Description:
+
+	Returns the number of rows and columns of the matrix.
+*/
+func (m *CSRMatrix) Dims() (int, int) {
+	return m.NRows, m.NCols
+}
+
+/*
+NNZ
+Description:
+
+	Returns the number of stored (nonzero) entries of the matrix.
+*/
+func (m *CSRMatrix) NNZ() int {
+	return len(m.Values)
+}
+
+/*
+Row
+Description:
+
+	Returns the column indices and the values of the nonzero entries of row i.
+	The slices share their memory with the matrix and must not be modified.
+*/
+func (m *CSRMatrix) Row(i int) ([]int, []float64) {
+	start, end := m.RowStarts[i], m.RowStarts[i+1]
+	return m.ColumnIndices[start:end], m.Values[start:end]
+}
+
+/*
+MulVec
+Description:
+
+	Returns the product m * x.
+*/
+func (m *CSRMatrix) MulVec(x []float64) []float64 {
+	out := make([]float64, m.NRows)
+	for ii := 0; ii < m.NRows; ii++ {
+		cols, values := m.Row(ii)
+		for k, jj := range cols {
+			out[ii] += values[k] * x[jj]
+		}
+	}
+	return out
+}
+
+/*
+TMulVec
+Description:
+
+	Returns the product m^T * y, visiting only the rows for which y is nonzero.
+*/
+func (m *CSRMatrix) TMulVec(y []float64) []float64 {
+	out := make([]float64, m.NCols)
+	for ii := 0; ii < m.NRows; ii++ {
+		if y[ii] == 0 {
+			continue
+		}
+		cols, values := m.Row(ii)
+		for k, jj := range cols {
+			out[jj] += values[k] * y[ii]
+		}
+	}
+	return out
+}
@@ -0,0 +1,445 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MatProGo-dev/MatProInterface.go/problem"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+// signTolerance is the magnitude below which a bound is treated as zero when deciding
+// whether a variable needs a positive and a negative part in the sparse standard form.
+const signTolerance = 1e-8
+
+/*
+SparseStandardForm
+Description:
+
+	The standard form of a linear program (the same one that GetInitialTableauFrom uses)
+	with a sparse constraint matrix:
+
+		maximize 	C^T * x + D
+		subject to 	A * x = B
+					x >= 0
+
+	SlackBasis contains the slack variable of each row, which form the initial basis.
+	Unlike GetInitialTableauFrom, no dense matrix is ever built, so that problems with
+	many rows and columns but few nonzeros fit in memory.
+*/
+type SparseStandardForm struct {
+	Variables  []symbolic.Variable
+	A          CSCMatrix
+	B          []float64
+	C          []float64
+	D          float64
+	SlackBasis []int
+}
+
+/*
+GetSparseStandardFormFrom
+Description:
+
+	Computes the sparse standard form of the linear program problemIn and the expressions
+	of the original variables in terms of the standard form variables.
+	The standard form is the one of ToLPStandardForm2: each variable is split into a
+	positive and a negative part (a part is left out if the variable's bounds or a
+	constraint on the variable alone rule it out, i.e., if an upper bound is at most
+	signTolerance or a lower bound is at least -signTolerance), the nonnegativity constraints are
+	dropped, each inequality gets a slack variable and a minimization is turned into a
+	maximization. Unlike ToLPStandardForm2, the rows are read one constraint at a time
+	without any symbolic substitution, so that the cost grows with the number of nonzeros.
+	Returns an error if a row of the standard form has no slack variable (e.g., for equality
+	constraints), since the slack variables must form the initial basis.
+*/
+func GetSparseStandardFormFrom(problemIn *problem.OptimizationProblem) (SparseStandardForm, map[symbolic.Variable]symbolic.Expression, error) {
+	// Input Processing
+	if problemIn == nil {
+		return SparseStandardForm{}, nil, fmt.Errorf("GetSparseStandardFormFrom: the problem cannot be nil")
+	}
+	err := problemIn.Check()
+	if err != nil {
+		return SparseStandardForm{}, nil, fmt.Errorf("GetSparseStandardFormFrom: the problem is not well defined: %v", err)
+	}
+	if !problemIn.IsLinear() {
+		return SparseStandardForm{}, nil, fmt.Errorf("GetSparseStandardFormFrom: the problem is not a linear program")
+	}
+
+	// Read the rows (sum_j a_j x_j + k <sense> 0) of the scalar constraints
+	originalColumnOf := make(map[uint64]int, len(problemIn.Variables))
+	for jj, v := range problemIn.Variables {
+		originalColumnOf[v.ID] = jj
+	}
+	constraints := ExtractScalarConstraints(problemIn.Constraints)
+	rows := make([]sparseRow, len(constraints))
+	for ii, constraint := range constraints {
+		rows[ii], err = sparseRowOf(constraint, originalColumnOf)
+		if err != nil {
+			return SparseStandardForm{}, nil, fmt.Errorf("GetSparseStandardFormFrom: constraint %v: %v", ii, err)
+		}
+	}
+
+	// Decide which parts of each variable exist
+	hasPositivePart := make([]bool, len(problemIn.Variables))
+	hasNegativePart := make([]bool, len(problemIn.Variables))
+	for jj, v := range problemIn.Variables {
+		hasPositivePart[jj] = v.Upper > signTolerance
+		hasNegativePart[jj] = v.Lower < -signTolerance
+	}
+	for _, row := range rows {
+		if len(row.cols) != 1 {
+			continue
+		}
+		lower, upper := row.boundsOfSingleVariable()
+		if lower >= -signTolerance {
+			hasNegativePart[row.cols[0]] = false
+		}
+		if upper <= signTolerance {
+			hasPositivePart[row.cols[0]] = false
+		}
+	}
+
+	// Create the standard form variables and the expressions of the original variables
+	variableFactory := problem.NewProblem("sparse standard form")
+	newVariable := func(suffix string, prefix ...string) int {
+		variableFactory.AddVariableClassic(0.0, symbolic.Infinity.Constant(), symbolic.Continuous)
+		colIdx := len(variableFactory.Variables) - 1
+		if len(prefix) > 0 {
+			variableFactory.Variables[colIdx].Name = prefix[0]
+		}
+		variableFactory.Variables[colIdx].Name += suffix
+		return colIdx
+	}
+
+	positiveColumn := make([]int, len(problemIn.Variables))
+	negativeColumn := make([]int, len(problemIn.Variables))
+	varMap := make(map[symbolic.Variable]symbolic.Expression, len(problemIn.Variables))
+	for jj, v := range problemIn.Variables {
+		positiveColumn[jj], negativeColumn[jj] = -1, -1
+		var expr symbolic.Expression = symbolic.K(0.0)
+		if hasPositivePart[jj] {
+			positiveColumn[jj] = newVariable(" (+)", v.Name)
+			expr = variableFactory.Variables[positiveColumn[jj]]
+		}
+		if hasNegativePart[jj] {
+			negativeColumn[jj] = newVariable(" (-)", v.Name)
+			expr = expr.Minus(variableFactory.Variables[negativeColumn[jj]])
+		}
+		varMap[v] = expr
+	}
+
+	// Collect the nonzeros of A (dropping the nonnegativity constraints)
+	var tripletRows, tripletCols []int
+	var tripletValues []float64
+	addEntries := func(row int, originalCol int, value float64) {
+		if positiveColumn[originalCol] != -1 {
+			tripletRows, tripletCols = append(tripletRows, row), append(tripletCols, positiveColumn[originalCol])
+			tripletValues = append(tripletValues, value)
+		}
+		if negativeColumn[originalCol] != -1 {
+			tripletRows, tripletCols = append(tripletRows, row), append(tripletCols, negativeColumn[originalCol])
+			tripletValues = append(tripletValues, -value)
+		}
+	}
+
+	out := SparseStandardForm{}
+	for ii, row := range rows {
+		if row.isNonnegativityConstraint() {
+			continue
+		}
+		if row.sense == symbolic.SenseEqual {
+			return SparseStandardForm{}, nil, fmt.Errorf(
+				"GetSparseStandardFormFrom: row %v of the standard form (constraint %v) has no slack variable (equality constraints are not supported)",
+				len(out.B),
+				ii,
+			)
+		}
+
+		rowIdx := len(out.B)
+		for k, colIdx := range row.cols {
+			addEntries(rowIdx, colIdx, row.values[k])
+		}
+		out.B = append(out.B, -row.constant)
+
+		// The slack is added to the left hand side of a <= constraint and to the right hand side of a >= constraint
+		slackColumn := newVariable(" (slack)")
+		slackCoeff := 1.0
+		if row.sense == symbolic.SenseGreaterThanEqual {
+			slackCoeff = -1.0
+		}
+		tripletRows, tripletCols = append(tripletRows, rowIdx), append(tripletCols, slackColumn)
+		tripletValues = append(tripletValues, slackCoeff)
+		out.SlackBasis = append(out.SlackBasis, slackColumn)
+	}
+	out.Variables = variableFactory.Variables
+
+	out.A, err = NewCSCMatrixFromTriplets(len(out.B), len(out.Variables), tripletRows, tripletCols, tripletValues)
+	if err != nil {
+		return SparseStandardForm{}, nil, err
+	}
+
+	// Objective (as a maximization)
+	objective, ok := problemIn.Objective.Expression.(symbolic.ScalarExpression)
+	if !ok {
+		return SparseStandardForm{}, nil, fmt.Errorf("GetSparseStandardFormFrom: the objective is not a scalar expression")
+	}
+	cols, coeffs, err := sparseCoefficientsOf(objective, originalColumnOf)
+	if err != nil {
+		return SparseStandardForm{}, nil, fmt.Errorf("GetSparseStandardFormFrom: the objective: %v", err)
+	}
+	sign := 1.0
+	if problemIn.Objective.Sense == problem.SenseMinimize {
+		sign = -1.0
+	}
+	out.C = make([]float64, len(out.Variables))
+	for k, colIdx := range cols {
+		if positiveColumn[colIdx] != -1 {
+			out.C[positiveColumn[colIdx]] += sign * coeffs[k]
+		}
+		if negativeColumn[colIdx] != -1 {
+			out.C[negativeColumn[colIdx]] -= sign * coeffs[k]
+		}
+	}
+	out.D = sign * objective.Constant()
+
+	return out, varMap, nil
+}
+
+/*
+ValuesOf
+Description:
+
+	Computes the value of each original variable (by ID) from the values x of the
+	standard form variables, using the expressions of the original variables in
+	terms of the standard form variables (see GetSparseStandardFormFrom).
+*/
+func (sf *SparseStandardForm) ValuesOf(x []float64, varMap map[symbolic.Variable]symbolic.Expression) (map[uint64]float64, error) {
+	// Setup
+	columnOf := make(map[uint64]int, len(sf.Variables))
+	for jj, v := range sf.Variables {
+		columnOf[v.ID] = jj
+	}
+
+	out := make(map[uint64]float64, len(varMap))
+	for origVar, expr := range varMap {
+		scalarExpr, ok := expr.(symbolic.ScalarExpression)
+		if !ok {
+			return nil, fmt.Errorf("ValuesOf: the expression of variable %v is not a scalar expression", origVar)
+		}
+
+		jj, coeffs, err := sparseCoefficientsOf(scalarExpr, columnOf)
+		if err != nil {
+			return nil, fmt.Errorf("ValuesOf: variable %v: %v", origVar, err)
+		}
+		value := scalarExpr.Constant()
+		for k, colIdx := range jj {
+			value += coeffs[k] * x[colIdx]
+		}
+		out[origVar.ID] = value
+	}
+
+	return out, nil
+}
+
+/*
+sparseCoefficientsOf
+Description:
+
+	Returns the columns (according to columnOf) and the coefficients of the variables
+	of the linear expression expr. Only the expression's own variables are visited.
+*/
+func sparseCoefficientsOf(expr symbolic.ScalarExpression, columnOf map[uint64]int) ([]int, []float64, error) {
+	// Input Processing
+	if !symbolic.IsLinear(expr) {
+		return nil, nil, fmt.Errorf("the expression is not linear")
+	}
+
+	// Collect the coefficients
+	variables, coeffs := SparseLinearCoeff(expr)
+	cols := make([]int, 0, len(variables))
+	values := make([]float64, 0, len(variables))
+	for k, v := range variables {
+		colIdx, found := columnOf[v.ID]
+		if !found {
+			return nil, nil, fmt.Errorf("the variable %v is not a variable of the problem", v)
+		}
+		if coeffs[k] != 0 {
+			cols = append(cols, colIdx)
+			values = append(values, coeffs[k])
+		}
+	}
+
+	return cols, values, nil
+}
+
+/*
+SparseLinearCoeff
+Description:
+
+	Returns the variables of the linear expression expr and their coefficients.
+	Unlike expr.LinearCoeff(expr.Variables()), the monomials of a polynomial are
+	visited only once, so that the cost grows linearly with the number of terms.
+*/
+func SparseLinearCoeff(expr symbolic.ScalarExpression) ([]symbolic.Variable, []float64) {
+	// Setup
+	var variables []symbolic.Variable
+	var coeffs []float64
+	position := map[uint64]int{}
+	add := func(v symbolic.Variable, coeff float64) {
+		if k, found := position[v.ID]; found {
+			coeffs[k] += coeff
+			return
+		}
+		position[v.ID] = len(variables)
+		variables = append(variables, v)
+		coeffs = append(coeffs, coeff)
+	}
+
+	// Collect the coefficients of the (degree one) monomials
+	switch concrete := expr.(type) {
+	case symbolic.K:
+	case symbolic.Variable:
+		add(concrete, 1.0)
+	case symbolic.Monomial:
+		if len(concrete.VariableFactors) == 1 {
+			add(concrete.VariableFactors[0], concrete.Coefficient)
+		}
+	case symbolic.Polynomial:
+		for _, monomial := range concrete.Monomials {
+			if len(monomial.VariableFactors) == 1 {
+				add(monomial.VariableFactors[0], monomial.Coefficient)
+			}
+		}
+	default:
+		variables = expr.Variables()
+		if len(variables) == 0 {
+			return nil, nil
+		}
+		linearCoeff := expr.LinearCoeff(variables)
+		coeffs = make([]float64, len(variables))
+		for k := range variables {
+			coeffs[k] = linearCoeff.AtVec(k)
+		}
+	}
+
+	return variables, coeffs
+}
+
+/*
+sparseRow
+Description:
+
+	A scalar constraint written as sum_k values[k] * x_{cols[k]} + constant <sense> 0,
+	where cols are the columns of the original problem's variables.
+*/
+type sparseRow struct {
+	cols     []int
+	values   []float64
+	constant float64
+	sense    symbolic.ConstrSense
+}
+
+/*
+sparseRowOf
+Description:
+
+	Moves everything in the constraint to the left hand side and collects the
+	coefficients of the variables (according to columnOf).
+*/
+func sparseRowOf(constraint symbolic.ScalarConstraint, columnOf map[uint64]int) (sparseRow, error) {
+	// Setup
+	lhs, ok := constraint.Left().(symbolic.ScalarExpression)
+	if !ok {
+		return sparseRow{}, fmt.Errorf("the left hand side is not a scalar expression")
+	}
+	rhs, ok := constraint.Right().(symbolic.ScalarExpression)
+	if !ok {
+		return sparseRow{}, fmt.Errorf("the right hand side is not a scalar expression")
+	}
+
+	lhsCols, lhsValues, err := sparseCoefficientsOf(lhs, columnOf)
+	if err != nil {
+		return sparseRow{}, err
+	}
+	rhsCols, rhsValues, err := sparseCoefficientsOf(rhs, columnOf)
+	if err != nil {
+		return sparseRow{}, err
+	}
+
+	// Combine both sides (adding the coefficients of variables that appear on both)
+	out := sparseRow{
+		constant: lhs.Constant() - rhs.Constant(),
+		sense:    constraint.ConstrSense(),
+	}
+	position := map[int]int{}
+	add := func(colIdx int, value float64) {
+		if k, found := position[colIdx]; found {
+			out.values[k] += value
+			return
+		}
+		position[colIdx] = len(out.cols)
+		out.cols = append(out.cols, colIdx)
+		out.values = append(out.values, value)
+	}
+	for k, colIdx := range lhsCols {
+		add(colIdx, lhsValues[k])
+	}
+	for k, colIdx := range rhsCols {
+		add(colIdx, -rhsValues[k])
+	}
+
+	// Drop the coefficients that cancelled out
+	kept := 0
+	for k := range out.cols {
+		if out.values[k] != 0 {
+			out.cols[kept], out.values[kept] = out.cols[k], out.values[k]
+			kept++
+		}
+	}
+	out.cols, out.values = out.cols[:kept], out.values[:kept]
+
+	return out, nil
+}
+
+/*
+boundsOfSingleVariable
+Description:
+
+	Returns the lower and upper bound that a row with a single variable puts on it
+	(-Inf or +Inf if the row does not bound the variable from that side).
+*/
+func (row sparseRow) boundsOfSingleVariable() (float64, float64) {
+	// Setup
+	a := row.values[0]
+	bound := -row.constant / a
+	sense := row.sense
+	if a < 0 && sense != symbolic.SenseEqual {
+		// Dividing by a negative coefficient flips the sense
+		sense = map[symbolic.ConstrSense]symbolic.ConstrSense{
+			symbolic.SenseLessThanEqual:    symbolic.SenseGreaterThanEqual,
+			symbolic.SenseGreaterThanEqual: symbolic.SenseLessThanEqual,
+		}[sense]
+	}
+
+	switch sense {
+	case symbolic.SenseGreaterThanEqual:
+		return bound, math.Inf(1)
+	case symbolic.SenseLessThanEqual:
+		return math.Inf(-1), bound
+	}
+	return bound, bound
+}
+
+/*
+isNonnegativityConstraint
+Description:
+
+	Returns true if the row is of the form x >= 0 (or a*x >= 0 with a > 0, or a*x <= 0 with a < 0).
+*/
+func (row sparseRow) isNonnegativityConstraint() bool {
+	if len(row.cols) != 1 || row.constant != 0 || row.sense == symbolic.SenseEqual {
+		return false
+	}
+	lower, _ := row.boundsOfSingleVariable()
+	return lower == 0
+}
@@ -244,6 +244,61 @@ func (tableau *Tableau) Check() error {
 	return nil
 }
 
+/*
+CheckSlackBasis
+Description:
+
+	Returns an error if the basic variables of the tableau (e.g., the slacks of an initial
+	tableau) are not a feasible basis from which the simplex method can start:
+	- every constraint row must have a basic variable (the rows of equality constraints
+	  have no slack),
+	- the column of each basic variable must be the unit vector of its row (the surplus
+	  of a >= constraint has the coefficient -1) and
+	- the right hand side must be nonnegative.
+*/
+func (tableau *Tableau) CheckSlackBasis() error {
+	// Setup
+	T := tableau.AsCompressedMatrix
+	nRows, nCols := T.Dims()
+
+	// Check that every row has a basic variable
+	if len(tableau.BasicVariableIndicies) != nRows-1 {
+		return fmt.Errorf(
+			"the slack basis has %v basic variables for %v constraints (equality constraints are not supported)",
+			len(tableau.BasicVariableIndicies),
+			nRows-1,
+		)
+	}
+
+	for ii, bvIdx := range tableau.BasicVariableIndicies {
+		// Check that the basic variable only appears in its row (with the coefficient 1)
+		for rr := 0; rr < nRows; rr++ {
+			expected := 0.0
+			if rr == ii+1 {
+				expected = 1.0
+			}
+			if T.At(rr, bvIdx) != expected {
+				return fmt.Errorf(
+					"the slack basis does not fit constraint %v: the column of %v is not a unit column (>= constraints are not supported)",
+					ii,
+					tableau.Variables[bvIdx],
+				)
+			}
+		}
+
+		// Check that the basic solution is feasible
+		if T.At(ii+1, nCols-1) < 0 {
+			return fmt.Errorf(
+				"the slack basis is infeasible (constraint %v has the right hand side %v)",
+				ii,
+				T.At(ii+1, nCols-1),
+			)
+		}
+	}
+
+	return nil
+}
+
 /*
 CNonBasic
 Description:
@@ -320,6 +375,22 @@ func (tableau *Tableau) NonBasicVariables() []symbolic.Variable {
 	return out
 }
 
+/*
+VariableIndex
+Description:
+
+	Returns the index (in tableau.Variables) of the variable with the given name.
+	Returns an error if no such variable exists.
+*/
+func (tableau *Tableau) VariableIndex(name string) (int, error) {
+	for ii, v := range tableau.Variables {
+		if v.Name == name {
+			return ii, nil
+		}
+	}
+	return -1, fmt.Errorf("the tableau does not contain a variable named \"%v\"", name)
+}
+
 func (tableau *Tableau) NumberOfConstraints() int {
 	nRows, _ := tableau.AsCompressedMatrix.Dims()
 	return nRows - 1
@@ -370,8 +441,6 @@ func GetInitialTableauFrom(problemIn *problem.OptimizationProblem) (Tableau, map
 		return Tableau{}, nil, err
 	}
 
-	fmt.Println("Problem in standard form:", problemInStandardForm)
-
 	// Transform SlackVariables object into indicies
 	var slackVariableIndicies []int
 	for _, slackVar := range slackVariables {
@@ -445,14 +514,24 @@ Description:
 	any of the non-basic variables.
 */
 func (tableau *Tableau) CanNotBeImproved() bool {
-	// Get the coefficients of the non-basic variables
-	c := tableau.C()
+	return tableau.CanNotBeImprovedWithin(0.0)
+}
 
-	fmt.Println("c =", c)
+/*
+CanNotBeImprovedWithin
+Description:
+
+	Same as CanNotBeImproved, but coefficients of the objective row that are
+	greater than or equal to -tolerance are treated as nonnegative.
+*/
+func (tableau *Tableau) CanNotBeImprovedWithin(tolerance float64) bool {
+	// Setup (the entries are read from the compressed matrix, without copying c, A and b)
+	T := tableau.AsCompressedMatrix
+	nRows, nCols := T.Dims()
 
 	// Check if all coefficients are less than or equal to zero
-	for ii := 0; ii < c.Len(); ii++ {
-		if c.AtVec(ii) < 0 {
+	for jj := 0; jj < nCols-1; jj++ {
+		if T.At(0, jj) < -tolerance {
 			return false
 		}
 	}
@@ -460,15 +539,12 @@ func (tableau *Tableau) CanNotBeImproved() bool {
 	// For each coefficient that is negative, check if there is a corresponding
 	// row in the Tableau matrix that has a positive ratio (i.e., b[i] / A[i, enteringVarIdx] > 0).
 	// If there is no such row for any entering variable, then the problem can not be improved.
-	A := tableau.A()
-	nRowsA, _ := A.Dims()
-	b := tableau.B()
-	for enteringVarIdx := 0; enteringVarIdx < c.Len(); enteringVarIdx++ {
-		if c.AtVec(enteringVarIdx) < 0 {
+	for enteringVarIdx := 0; enteringVarIdx < nCols-1; enteringVarIdx++ {
+		if T.At(0, enteringVarIdx) < -tolerance {
 			// Check for positive ratios
 			hasPositiveRatio := false
-			for rowIdx := 0; rowIdx < nRowsA; rowIdx++ {
-				if b.AtVec(rowIdx)/A.At(rowIdx, enteringVarIdx) > 0 {
+			for rowIdx := 1; rowIdx < nRows; rowIdx++ {
+				if T.At(rowIdx, nCols-1)/T.At(rowIdx, enteringVarIdx) > 0 {
 					hasPositiveRatio = true
 					break
 				}
@@ -482,6 +558,39 @@ func (tableau *Tableau) CanNotBeImproved() bool {
 	return true
 }
 
+/*
+IsUnboundedWithin
+Description:
+
+	Returns true if a non-basic variable whose coefficient in the objective row is
+	less than -tolerance (i.e., which would improve the objective) has no entry greater
+	than pivotTolerance in its column. Such a variable can be increased without
+	bound while the basic variables stay nonnegative, so the objective is unbounded.
+	The entries are read from AsCompressedMatrix directly, without copying it.
+*/
+func (tableau *Tableau) IsUnboundedWithin(tolerance, pivotTolerance float64) bool {
+	// Setup
+	T := tableau.AsCompressedMatrix
+	nRows, nCols := T.Dims()
+
+	// Look for an improving column without a positive entry
+	for jj := 0; jj < nCols-1; jj++ {
+		if T.At(0, jj) >= -tolerance {
+			continue
+		}
+
+		isBounded := false
+		for ii := 1; ii < nRows && !isBounded; ii++ {
+			isBounded = T.At(ii, jj) > pivotTolerance
+		}
+		if !isBounded {
+			return true
+		}
+	}
+
+	return false
+}
+
 /*
 ComputeFeasibleSolution
 Description:
@@ -602,78 +711,186 @@ func (tableau *Tableau) Pivot(enteringVarIdx int, exitingVarIdx int) (Tableau, e
 		return Tableau{}, fmt.Errorf("Pivot: %v", err)
 	}
 
-	// Check that the entering variable is not already a basic variable
-	if foundIdx, _ := symbolic.FindInSlice(enteringVarIdx, tableau.BasicVariableIndicies); foundIdx != -1 {
-		return Tableau{}, fmt.Errorf("Pivot: the entering variable is already a basic variable")
+	// Create the new tableau (as a copy of this one) and pivot it
+	// (PivotInPlace checks the entering and exiting variables)
+	newTableau := Tableau{
+		Variables:             tableau.Variables,
+		BasicVariableIndicies: append([]int{}, tableau.BasicVariableIndicies...),
+		AsCompressedMatrix:    mat.DenseCopyOf(tableau.AsCompressedMatrix),
+	}
+	err = newTableau.PivotInPlace(enteringVarIdx, exitingVarIdx)
+	if err != nil {
+		return Tableau{}, err
 	}
 
-	// Check that the exiting variable is a basic variable
-	if foundIdx, _ := symbolic.FindInSlice(exitingVarIdx, tableau.BasicVariableIndicies); foundIdx == -1 {
-		return Tableau{}, fmt.Errorf("Pivot: the exiting variable is not a basic variable")
+	// Check the new tableau for validity
+	err = newTableau.Check()
+	if err != nil {
+		return Tableau{}, fmt.Errorf("Pivot: the resulting tableau is invalid (%v)", err)
 	}
 
-	// Setup
+	return newTableau, nil
+}
+
+/*
+PivotInPlace
+Description:
+
+	Performs the same pivot as Pivot, but overwrites this tableau's matrix and basic
+	variable indicies instead of creating a new tableau, so that no memory is allocated.
+	Only the pivot is validated (the tableau is not checked before or after the pivot),
+	and other tableaus that share the matrix or the basic variable indicies change too.
+*/
+func (tableau *Tableau) PivotInPlace(enteringVarIdx int, exitingVarIdx int) error {
+	// Input Processing
 	nRows, nCols := tableau.AsCompressedMatrix.Dims()
-	newTableauMat := mat.NewDense(nRows, nCols, nil)
-	newTableauMat.Copy(tableau.AsCompressedMatrix)
+	if enteringVarIdx < 0 || enteringVarIdx >= nCols-1 {
+		return fmt.Errorf("Pivot: the entering variable %v is outside of the expected range [0,%v]", enteringVarIdx, nCols-2)
+	}
 
-	exitingConstraintIdx, err := symbolic.FindInSlice(exitingVarIdx, tableau.BasicVariableIndicies)
-	if err != nil {
-		return Tableau{}, fmt.Errorf("Pivot: could not find exiting variable in basic variable indicies (%v)", err)
+	exitingConstraintIdx := -1
+	for ii, bvIdx := range tableau.BasicVariableIndicies {
+		if bvIdx == enteringVarIdx {
+			return fmt.Errorf("Pivot: the entering variable is already a basic variable")
+		}
+		if bvIdx == exitingVarIdx && exitingConstraintIdx == -1 {
+			exitingConstraintIdx = ii
+		}
+	}
+	if exitingConstraintIdx == -1 {
+		return fmt.Errorf("Pivot: the exiting variable is not a basic variable")
+	}
+
+	pivotRowIdx := exitingConstraintIdx + 1
+	pivotElement := tableau.AsCompressedMatrix.At(pivotRowIdx, enteringVarIdx)
+	if pivotElement == 0 {
+		return fmt.Errorf("Pivot: the pivot element (in the row of the exiting variable and the column of the entering variable) is zero")
+	}
+
+	// Setup
+	raw := tableau.AsCompressedMatrix.RawMatrix()
+	rowOf := func(ii int) []float64 {
+		return raw.Data[ii*raw.Stride : ii*raw.Stride+nCols]
 	}
 
 	// Perform the pivot operation
-	// - "Normalize" the pivot element (i.e., the element at A[exitingVarIdx, enteringVarIdx])
-	normalizingFactor := 1.0 / tableau.AsCompressedMatrix.At(exitingConstraintIdx+1, enteringVarIdx)
-	newPivotRow := mat.NewVecDense(nCols, nil)
-	for jj := 0; jj < nCols; jj++ {
-		newPivotRow.SetVec(jj, tableau.AsCompressedMatrix.At(exitingConstraintIdx+1, jj)*normalizingFactor)
+	// - "Normalize" the pivot row
+	normalizingFactor := 1.0 / pivotElement
+	pivotRow := rowOf(pivotRowIdx)
+	for jj := range pivotRow {
+		pivotRow[jj] *= normalizingFactor
 	}
-	newTableauMat.SetRow(exitingConstraintIdx+1, newPivotRow.RawVector().Data)
+	pivotRow[enteringVarIdx] = 1.0
 
 	// - Zero out the other entries in the entering variable column
+	//   (exactly, so that rounding errors cannot make the entering variable look improvable)
 	for ii := 0; ii < nRows; ii++ {
-		// Skip the pivot row (i.e., the row of the entering variable)
-		if ii == exitingConstraintIdx+1 {
+		row := rowOf(ii)
+		// Skip the pivot row (and any row that is already zero below)
+		if ii == pivotRowIdx {
 			continue
 		}
-		// Skip any row that is already zero
-		if math.Abs(newTableauMat.At(ii, enteringVarIdx)) < 1e-14 {
+		if math.Abs(row[enteringVarIdx]) < 1e-14 {
+			row[enteringVarIdx] = 0.0
 			continue
 		}
 
-		// Otherwise, determine the factor needed to zero out this entry
-		factorToZeroOut := -1.0 * newTableauMat.At(ii, enteringVarIdx)
-		rowToAdd := mat.NewVecDense(nCols, nil)
-		rowToAdd.ScaleVec(factorToZeroOut, newPivotRow)
-		rowToAdd.AddVec(rowToAdd, newTableauMat.RowView(ii))
-
-		// Update the row in the new tableau
-		newTableauMat.SetRow(ii, rowToAdd.RawVector().Data)
+		factorToZeroOut := -1.0 * row[enteringVarIdx]
+		for jj := range row {
+			row[jj] += factorToZeroOut * pivotRow[jj]
+		}
+		row[enteringVarIdx] = 0.0
 	}
 
 	// Update the list of basic variable indicies
-	newBasicVariableIndicies := make([]int, len(tableau.BasicVariableIndicies))
-	copy(newBasicVariableIndicies, tableau.BasicVariableIndicies)
-	for ii, bvIdx := range tableau.BasicVariableIndicies {
-		if bvIdx == exitingVarIdx {
-			newBasicVariableIndicies[ii] = enteringVarIdx
-			break
+	tableau.BasicVariableIndicies[exitingConstraintIdx] = enteringVarIdx
+
+	return nil
+}
+
+/*
+RecomputeTableau
+Description:
+
+	Recomputes the tableau of the given basis directly from the initial tableau
+	(e.g., the tableau of the slack basis) instead of through a sequence of pivots.
+	If B is the matrix of the basic columns of the initial tableau's A, then the
+	constraint rows of the result are B^{-1} [A | b] and its objective row is the
+	initial objective row minus the multiples of these rows that zero out the basic
+	columns. The basic columns of the result are exact unit vectors.
+	Returns an error if the basis matrix is singular (or too badly conditioned to be factorized).
+*/
+func RecomputeTableau(initial Tableau, basicVariableIndicies []int) (Tableau, error) {
+	// Input Processing
+	err := initial.Check()
+	if err != nil {
+		return Tableau{}, fmt.Errorf("RecomputeTableau: %v", err)
+	}
+
+	nRows, nCols := initial.AsCompressedMatrix.Dims()
+	nConstraints := nRows - 1
+	if len(basicVariableIndicies) != nConstraints {
+		return Tableau{}, fmt.Errorf(
+			"RecomputeTableau: expected %v basic variables, but received %v",
+			nConstraints,
+			len(basicVariableIndicies),
+		)
+	}
+	for _, bvIdx := range basicVariableIndicies {
+		if bvIdx < 0 || bvIdx >= len(initial.Variables) {
+			return Tableau{}, fmt.Errorf("RecomputeTableau: the basic variable %v does not exist", bvIdx)
 		}
 	}
+	if nConstraints == 0 {
+		return Tableau{
+			Variables:             initial.Variables,
+			BasicVariableIndicies: []int{},
+			AsCompressedMatrix:    mat.DenseCopyOf(initial.AsCompressedMatrix),
+		}, nil
+	}
 
-	// Create the new tableau
-	newTableau := Tableau{
-		Variables:             tableau.Variables,
-		BasicVariableIndicies: newBasicVariableIndicies,
-		AsCompressedMatrix:    newTableauMat,
+	// Factorize the basis matrix
+	basisMatrix := mat.NewDense(nConstraints, nConstraints, nil)
+	for ii, bvIdx := range basicVariableIndicies {
+		for rr := 0; rr < nConstraints; rr++ {
+			basisMatrix.Set(rr, ii, initial.AsCompressedMatrix.At(rr+1, bvIdx))
+		}
 	}
 
-	// Check the new tableau for validity
-	err = newTableau.Check()
+	var lu mat.LU
+	lu.Factorize(basisMatrix)
+
+	// Solve B X = [A | b] for the constraint rows
+	var rows mat.Dense
+	err = lu.SolveTo(&rows, false, initial.AsCompressedMatrix.Slice(1, nRows, 0, nCols))
 	if err != nil {
-		return Tableau{}, fmt.Errorf("Pivot: the resulting tableau is invalid (%v)", err)
+		return Tableau{}, fmt.Errorf("RecomputeTableau: the basis matrix could not be factorized (%v)", err)
 	}
 
-	return newTableau, nil
+	// Assemble the tableau
+	out := mat.NewDense(nRows, nCols, nil)
+	for jj := 0; jj < nCols; jj++ {
+		objectiveEntry := initial.AsCompressedMatrix.At(0, jj)
+		for ii, bvIdx := range basicVariableIndicies {
+			objectiveEntry -= initial.AsCompressedMatrix.At(0, bvIdx) * rows.At(ii, jj)
+		}
+		out.Set(0, jj, objectiveEntry)
+
+		for ii := 0; ii < nConstraints; ii++ {
+			out.Set(ii+1, jj, rows.At(ii, jj))
+		}
+	}
+
+	for ii, bvIdx := range basicVariableIndicies {
+		for rr := 0; rr < nRows; rr++ {
+			out.Set(rr, bvIdx, 0.0)
+		}
+		out.Set(ii+1, bvIdx, 1.0)
+	}
+
+	return Tableau{
+		Variables:             initial.Variables,
+		BasicVariableIndicies: append([]int{}, basicVariableIndicies...),
+		AsCompressedMatrix:    out,
+	}, nil
 }
@@ -0,0 +1,212 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+TableauFormatOptions
+Description:
+
+	Controls how a tableau is printed by FormatWith.
+	- Compact omits every column that is zero in all rows (including the objective row).
+	- Markdown prints the tableau as a Markdown table instead of aligned plain text.
+*/
+type TableauFormatOptions struct {
+	Compact  bool
+	Markdown bool
+}
+
+/*
+String
+Description:
+
+	Returns the tableau as aligned plain text with variable names as column headers,
+	the basic variable of each row as row labels, the objective row first and the
+	right hand side as the last column.
+*/
+func (tableau *Tableau) String() string {
+	return tableau.FormatWith(TableauFormatOptions{})
+}
+
+/*
+Format
+Description:
+
+	Implements fmt.Formatter for the %v and %s verbs.
+	The '#' flag (e.g., %#v) prints the compact form and the '+' flag (e.g., %+v)
+	prints a Markdown table. The flags can be combined.
+*/
+func (tableau *Tableau) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		fmt.Fprint(f, tableau.FormatWith(TableauFormatOptions{
+			Compact:  f.Flag('#'),
+			Markdown: f.Flag('+'),
+		}))
+	default:
+		fmt.Fprintf(f, "%%!%c(*utils.Tableau)", verb)
+	}
+}
+
+/*
+FormatWith
+Description:
+
+	Prints the tableau according to the given options.
+	If the tableau is not valid, then the error from Check() is printed instead.
+*/
+func (tableau *Tableau) FormatWith(options TableauFormatOptions) string {
+	// Check that tableau is valid
+	err := tableau.Check()
+	if err != nil {
+		return fmt.Sprintf("invalid tableau: %v", err)
+	}
+
+	// Setup
+	nTableauRows, nTableauCols := tableau.AsCompressedMatrix.Dims()
+
+	// Select the columns to print
+	columns := []int{}
+	for jj := 0; jj < nTableauCols; jj++ {
+		if options.Compact && jj < nTableauCols-1 && tableau.columnIsZero(jj) {
+			continue
+		}
+		columns = append(columns, jj)
+	}
+
+	// Assemble the cells of the table
+	header := []string{"Basis"}
+	for _, jj := range columns[:len(columns)-1] {
+		header = append(header, tableau.Variables[jj].Name)
+	}
+	header = append(header, "RHS")
+
+	rows := [][]string{}
+	basicVariables := tableau.BasicVariables()
+	for ii := 0; ii < nTableauRows; ii++ {
+		label := "z"
+		if ii > 0 {
+			label = basicVariables[ii-1].Name
+		}
+
+		row := []string{label}
+		for _, jj := range columns {
+			row = append(row, formatTableauEntry(tableau.AsCompressedMatrix.At(ii, jj)))
+		}
+		rows = append(rows, row)
+	}
+
+	// Print the table
+	var out string
+	if options.Markdown {
+		out = formatMarkdownTable(header, rows)
+	} else {
+		out = formatPlainTable(header, rows)
+	}
+
+	if nOmitted := nTableauCols - len(columns); nOmitted > 0 {
+		out += fmt.Sprintf("(%v zero columns omitted)\n", nOmitted)
+	}
+
+	return out
+}
+
+/*
+columnIsZero
+Description:
+
+	Returns true if every entry of the given column of the compressed matrix is zero.
+*/
+func (tableau *Tableau) columnIsZero(colIdx int) bool {
+	nTableauRows, _ := tableau.AsCompressedMatrix.Dims()
+	for ii := 0; ii < nTableauRows; ii++ {
+		if tableau.AsCompressedMatrix.At(ii, colIdx) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+formatPlainTable
+Description:
+
+	Prints the header and rows as aligned plain text. The first column (the row labels)
+	is left-aligned, all other columns are right-aligned and the objective row
+	is separated from the constraint rows.
+*/
+func formatPlainTable(header []string, rows [][]string) string {
+	// Compute the width of each column
+	widths := make([]int, len(header))
+	for _, line := range append([][]string{header}, rows...) {
+		for jj, cell := range line {
+			widths[jj] = max(widths[jj], len(cell))
+		}
+	}
+
+	// Create a function that pads each cell in a line
+	formatLine := func(line []string) string {
+		cells := make([]string, len(line))
+		for jj, cell := range line {
+			if jj == 0 {
+				cells[jj] = fmt.Sprintf("%-*v", widths[jj], cell)
+			} else {
+				cells[jj] = fmt.Sprintf("%*v", widths[jj], cell)
+			}
+		}
+		return strings.Join(cells, " | ") + "\n"
+	}
+
+	separators := make([]string, len(header))
+	for jj, width := range widths {
+		separators[jj] = strings.Repeat("-", width)
+	}
+	separator := strings.Join(separators, "-+-") + "\n"
+
+	// Print the table
+	var sb strings.Builder
+	sb.WriteString(formatLine(header))
+	sb.WriteString(separator)
+	for ii, row := range rows {
+		sb.WriteString(formatLine(row))
+		if ii == 0 {
+			sb.WriteString(separator)
+		}
+	}
+
+	return sb.String()
+}
+
+/*
+formatMarkdownTable
+Description:
+
+	Prints the header and rows as a Markdown table with right-aligned numeric columns.
+*/
+func formatMarkdownTable(header []string, rows [][]string) string {
+	// Create a function that escapes the pipes in each cell in a line
+	formatLine := func(line []string) string {
+		cells := make([]string, len(line))
+		for jj, cell := range line {
+			cells[jj] = strings.ReplaceAll(cell, "|", `\|`)
+		}
+		return "| " + strings.Join(cells, " | ") + " |\n"
+	}
+
+	alignments := []string{":---"}
+	for jj := 1; jj < len(header); jj++ {
+		alignments = append(alignments, "---:")
+	}
+
+	// Print the table
+	var sb strings.Builder
+	sb.WriteString(formatLine(header))
+	sb.WriteString("| " + strings.Join(alignments, " | ") + " |\n")
+	for _, row := range rows {
+		sb.WriteString(formatLine(row))
+	}
+
+	return sb.String()
+}
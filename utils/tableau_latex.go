@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+// subscriptedNamePattern matches variable names like "x_12" that can be written in math mode.
+var subscriptedNamePattern = regexp.MustCompile(`^([A-Za-z]+)_([0-9]+)$`)
+
+// latexEscaper escapes the characters that have a special meaning in LaTeX text mode.
+var latexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+)
+
+/*
+LaTeXVariableName
+Description:
+
+	Converts the name of a variable into a LaTeX string.
+	Names of the form "x_12" are written in math mode as $x_{12}$ and any suffix
+	after the first space (e.g., " (slack)") is written as escaped text.
+*/
+func LaTeXVariableName(name string) string {
+	// Split off the suffix (e.g., " (slack)")
+	base, suffix, _ := strings.Cut(name, " ")
+
+	// Write the base in math mode if possible
+	var out string
+	if matches := subscriptedNamePattern.FindStringSubmatch(base); matches != nil {
+		out = fmt.Sprintf("$%v_{%v}$", matches[1], matches[2])
+	} else {
+		out = latexEscaper.Replace(base)
+	}
+
+	if suffix != "" {
+		out += " " + latexEscaper.Replace(suffix)
+	}
+
+	return out
+}
+
+/*
+ToLaTeX
+Description:
+
+	Renders the tableau as a LaTeX tabular environment.
+	The first column contains the basic variable of each constraint row,
+	the header contains the variable names and the last column contains the
+	right hand side. The objective row is written first.
+*/
+func (tableau *Tableau) ToLaTeX() string {
+	return tableau.ToLaTeXWithPivot(-1, -1)
+}
+
+/*
+ToLaTeXWithPivot
+Description:
+
+	Renders the tableau as a LaTeX tabular environment (see ToLaTeX) where the
+	pivot element in the column of the entering variable and the row of the
+	exiting variable is highlighted with \boxed{} (which requires amsmath).
+	If either index is -1, then no element is highlighted.
+	If the tableau is not valid, then the error from Check() is rendered instead.
+*/
+func (tableau *Tableau) ToLaTeXWithPivot(enteringVarIdx int, exitingVarIdx int) string {
+	// Check that tableau is valid
+	err := tableau.Check()
+	if err != nil {
+		return latexEscaper.Replace(fmt.Sprintf("invalid tableau: %v", err)) + "\n"
+	}
+
+	// Setup
+	nTableauRows, nTableauCols := tableau.AsCompressedMatrix.Dims()
+	pivotRow := -1
+	if exitingVarIdx != -1 {
+		pivotRow, _ = symbolic.FindInSlice(exitingVarIdx, tableau.BasicVariableIndicies)
+	}
+
+	var sb strings.Builder
+
+	// Create the header
+	sb.WriteString(fmt.Sprintf("\\begin{tabular}{c|%v|c}\n", strings.Repeat("c", nTableauCols-1)))
+	header := []string{"Basis"}
+	for _, v := range tableau.Variables {
+		header = append(header, LaTeXVariableName(v.Name))
+	}
+	header = append(header, "RHS")
+	sb.WriteString(strings.Join(header, " & ") + " \\\\\n\\hline\n")
+
+	// Create one line per row of the tableau
+	for ii := 0; ii < nTableauRows; ii++ {
+		// Label the row
+		label := "$z$"
+		if ii > 0 {
+			label = LaTeXVariableName(tableau.BasicVariables()[ii-1].Name)
+		}
+
+		entries := []string{label}
+		for jj := 0; jj < nTableauCols; jj++ {
+			entry := "$" + formatTableauEntry(tableau.AsCompressedMatrix.At(ii, jj)) + "$"
+			if ii == pivotRow+1 && jj == enteringVarIdx && pivotRow != -1 {
+				entry = "$\\boxed{" + formatTableauEntry(tableau.AsCompressedMatrix.At(ii, jj)) + "}$"
+			}
+			entries = append(entries, entry)
+		}
+		sb.WriteString(strings.Join(entries, " & ") + " \\\\\n")
+
+		// Separate the objective row from the constraint rows
+		if ii == 0 {
+			sb.WriteString("\\hline\n")
+		}
+	}
+
+	sb.WriteString("\\end{tabular}\n")
+
+	return sb.String()
+}
+
+/*
+formatTableauEntry
+Description:
+
+	Formats a single entry of the tableau with up to 6 significant digits
+	(negative zeros are written as "0").
+*/
+func formatTableauEntry(value float64) string {
+	if value == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(value, 'g', 6, 64)
+}